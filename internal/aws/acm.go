@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListCertificates returns the ACM certificates of the region, whatever their
+// key algorithm.
+func ListCertificates(cfg aws.Config) ([]CertificateResp, error) {
+	client := acm.NewFromConfig(cfg)
+	var certs []CertificateResp
+	paginator := acm.NewListCertificatesPaginator(client, &acm.ListCertificatesInput{
+		Includes: &acmTypes.Filters{KeyTypes: acmTypes.KeyAlgorithm("").Values()},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing certificates, err: %v", err))
+			return nil, err
+		}
+		for _, c := range output.CertificateSummaryList {
+			var inUseBy []string
+			if aws.ToBool(c.InUse) {
+				if inUseBy, err = certUsers(client, aws.ToString(c.CertificateArn)); err != nil {
+					return nil, err
+				}
+			}
+			sans := strings.Join(c.SubjectAlternativeNameSummaries, ",")
+			if aws.ToBool(c.HasAdditionalSubjectAlternativeNames) {
+				sans += ",..."
+			}
+			certs = append(certs, CertificateResp{
+				Id:       arnResourceId(aws.ToString(c.CertificateArn)),
+				Domain:   aws.ToString(c.DomainName),
+				SANs:     sans,
+				Status:   string(c.Status),
+				Type:     string(c.Type),
+				InUseBy:  strings.Join(inUseBy, ","),
+				NotAfter: ecsLocalTime(c.NotAfter),
+				DaysLeft: daysLeft(c.NotAfter, time.Now()),
+				Arn:      aws.ToString(c.CertificateArn),
+			})
+		}
+	}
+	return certs, nil
+}
+
+// certUsage caches the resources using a certificate, as listing them takes
+// a DescribeCertificate call per certificate.
+type certUsage struct {
+	inUseBy []string
+	at      time.Time
+}
+
+const certUsageTTL = 10 * time.Minute
+
+var certUsages = struct {
+	sync.Mutex
+	m map[string]certUsage
+}{m: make(map[string]certUsage)}
+
+// certUsers returns the resources using a certificate, describing it at most
+// once per certUsageTTL.
+func certUsers(client *acm.Client, arn string) ([]string, error) {
+	certUsages.Lock()
+	u, ok := certUsages.m[arn]
+	certUsages.Unlock()
+	if ok && time.Since(u.at) < certUsageTTL {
+		return u.inUseBy, nil
+	}
+	cert, err := client.DescribeCertificate(context.TODO(), &acm.DescribeCertificateInput{CertificateArn: &arn})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing certificate %v, err: %v", arn, err))
+		return nil, err
+	}
+	var inUseBy []string
+	for _, user := range cert.Certificate.InUseBy {
+		inUseBy = append(inUseBy, arnResource(user))
+	}
+	certUsages.Lock()
+	certUsages.m[arn] = certUsage{inUseBy: inUseBy, at: time.Now()}
+	certUsages.Unlock()
+	return inUseBy, nil
+}
+
+// ExpiringCertificates counts the certificates expired or expiring within days.
+func ExpiringCertificates(certs []CertificateResp, days int) int {
+	var count int
+	for _, c := range certs {
+		if left, err := strconv.Atoi(c.DaysLeft); err == nil && left <= days {
+			count++
+		}
+	}
+	return count
+}
+
+// daysLeft returns the number of whole days until t, empty when t is unknown.
+func daysLeft(t *time.Time, now time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", int(math.Floor(t.Sub(now).Hours()/24)))
+}
+
+// arnResource returns the resource part of an arn, i.e loadbalancer/app/web/id.
+func arnResource(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return arn
+	}
+	return parts[5]
+}
+
+// arnResourceId returns the last segment of an arn resource.
+func arnResourceId(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// GetCertificate describes a certificate given its arn.
+func GetCertificate(cfg aws.Config, arn string) (*acmTypes.CertificateDetail, error) {
+	output, err := acm.NewFromConfig(cfg).DescribeCertificate(context.TODO(), &acm.DescribeCertificateInput{
+		CertificateArn: &arn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing certificate %v, err: %v", arn, err))
+		return nil, err
+	}
+	return output.Certificate, nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaysLeft(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	uu := map[string]struct {
+		notAfter *time.Time
+		want     string
+	}{
+		"unknown":  {want: ""},
+		"future":   {notAfter: at(45*24*time.Hour + time.Hour), want: "45"},
+		"today":    {notAfter: at(time.Hour), want: "0"},
+		"expired":  {notAfter: at(-time.Hour), want: "-1"},
+		"long-ago": {notAfter: at(-10 * 24 * time.Hour), want: "-10"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := daysLeft(u.notAfter, now); got != u.want {
+				t.Errorf("daysLeft() = %q, want %q", got, u.want)
+			}
+		})
+	}
+}
+
+func TestArnResource(t *testing.T) {
+	uu := map[string]struct {
+		arn, resource, id string
+	}{
+		"elb":        {"arn:aws:elasticloadbalancing:us-east-1:123:loadbalancer/app/web/50dc6c495c0c9188", "loadbalancer/app/web/50dc6c495c0c9188", "50dc6c495c0c9188"},
+		"cloudfront": {"arn:aws:cloudfront::123:distribution/E2QWRUHAPOMQZL", "distribution/E2QWRUHAPOMQZL", "E2QWRUHAPOMQZL"},
+		"cert":       {"arn:aws:acm:us-east-1:123:certificate/12345678-1234", "certificate/12345678-1234", "12345678-1234"},
+		"invalid":    {"invalid", "invalid", "invalid"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := arnResource(u.arn); got != u.resource {
+				t.Errorf("arnResource() = %q, want %q", got, u.resource)
+			}
+			if got := arnResourceId(u.arn); got != u.id {
+				t.Errorf("arnResourceId() = %q, want %q", got, u.id)
+			}
+		})
+	}
+}
+
+func TestExpiringCertificates(t *testing.T) {
+	certs := []CertificateResp{
+		{Id: "expired", DaysLeft: "-2"},
+		{Id: "soon", DaysLeft: "30"},
+		{Id: "later", DaysLeft: "31"},
+		{Id: "pending", DaysLeft: ""},
+	}
+	if got := ExpiringCertificates(certs, 30); got != 2 {
+		t.Errorf("ExpiringCertificates() = %d, want 2", got)
+	}
+}
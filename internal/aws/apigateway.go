@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigwTypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	apigwv2Types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ApiProtocolRest is the protocol of the APIs managed by API Gateway v1,
+// HTTP and WEBSOCKET APIs being managed by API Gateway v2.
+const ApiProtocolRest = "REST"
+
+// ListApis returns the REST, HTTP and WebSocket APIs of the region.
+func ListApis(cfg aws.Config) ([]ApiResp, error) {
+	var apis []ApiResp
+	paginator := apigateway.NewGetRestApisPaginator(apigateway.NewFromConfig(cfg), &apigateway.GetRestApisInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting rest apis, err: %v", err))
+			return nil, err
+		}
+		for _, a := range output.Items {
+			var types []string
+			if a.EndpointConfiguration != nil {
+				for _, t := range a.EndpointConfiguration.Types {
+					types = append(types, string(t))
+				}
+			}
+			apis = append(apis, ApiResp{
+				Id:           aws.ToString(a.Id),
+				Name:         aws.ToString(a.Name),
+				Protocol:     ApiProtocolRest,
+				EndpointType: strings.Join(types, ","),
+				Created:      ecsLocalTime(a.CreatedDate),
+				Description:  aws.ToString(a.Description),
+				Raw:          a,
+			})
+		}
+	}
+
+	client := apigatewayv2.NewFromConfig(cfg)
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		output, err := client.GetApis(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting apis, err: %v", err))
+			return nil, err
+		}
+		for _, a := range output.Items {
+			apis = append(apis, ApiResp{
+				Id:           aws.ToString(a.ApiId),
+				Name:         aws.ToString(a.Name),
+				Protocol:     string(a.ProtocolType),
+				EndpointType: string(apigwTypes.EndpointTypeRegional),
+				Created:      ecsLocalTime(a.CreatedDate),
+				Description:  aws.ToString(a.Description),
+				Raw:          a,
+			})
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return apis, nil
+}
+
+// ListApiStages returns the stages of an API along with their invoke URL.
+func ListApiStages(cfg aws.Config, protocol, apiId string) ([]ApiStageResp, error) {
+	if protocol == ApiProtocolRest {
+		output, err := apigateway.NewFromConfig(cfg).GetStages(context.TODO(), &apigateway.GetStagesInput{
+			RestApiId: &apiId,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting stages of rest api %v, err: %v", apiId, err))
+			return nil, err
+		}
+		stages := make([]ApiStageResp, 0, len(output.Item))
+		for _, s := range output.Item {
+			stages = append(stages, restStage(s, cfg.Region, apiId))
+		}
+		return stages, nil
+	}
+
+	client := apigatewayv2.NewFromConfig(cfg)
+	api, err := client.GetApi(context.TODO(), &apigatewayv2.GetApiInput{ApiId: &apiId})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting api %v, err: %v", apiId, err))
+		return nil, err
+	}
+	var stages []ApiStageResp
+	input := &apigatewayv2.GetStagesInput{ApiId: &apiId}
+	for {
+		output, err := client.GetStages(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting stages of api %v, err: %v", apiId, err))
+			return nil, err
+		}
+		for _, s := range output.Items {
+			stages = append(stages, httpStage(s, aws.ToString(api.ApiEndpoint)))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return stages, nil
+}
+
+func restStage(s apigwTypes.Stage, region, apiId string) ApiStageResp {
+	stage := ApiStageResp{
+		Stage:      aws.ToString(s.StageName),
+		Deployment: aws.ToString(s.DeploymentId),
+		AccessLog:  accessLogDestination(s.AccessLogSettings),
+		Updated:    ecsLocalTime(s.LastUpdatedDate),
+		InvokeUrl:  fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s", apiId, region, aws.ToString(s.StageName)),
+		Raw:        s,
+	}
+	// */* holds the settings applied to all the methods of the stage.
+	if m, ok := s.MethodSettings["*/*"]; ok {
+		stage.Throttling = throttling(m.ThrottlingRateLimit, m.ThrottlingBurstLimit)
+		stage.Logging = aws.ToString(m.LoggingLevel)
+	}
+	return stage
+}
+
+func httpStage(s apigwv2Types.Stage, endpoint string) ApiStageResp {
+	deployment := aws.ToString(s.DeploymentId)
+	if s.AutoDeploy {
+		deployment += " (auto)"
+	}
+	url := endpoint
+	if name := aws.ToString(s.StageName); name != "$default" {
+		url += "/" + name
+	}
+	stage := ApiStageResp{
+		Stage:      aws.ToString(s.StageName),
+		Deployment: deployment,
+		Updated:    ecsLocalTime(s.LastUpdatedDate),
+		InvokeUrl:  url,
+		Raw:        s,
+	}
+	if s.AccessLogSettings != nil {
+		stage.AccessLog = logGroupName(aws.ToString(s.AccessLogSettings.DestinationArn))
+	}
+	if r := s.DefaultRouteSettings; r != nil {
+		stage.Throttling = throttling(r.ThrottlingRateLimit, r.ThrottlingBurstLimit)
+		stage.Logging = string(r.LoggingLevel)
+	}
+	return stage
+}
+
+func throttling(rate float64, burst int32) string {
+	if rate == 0 && burst == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%g/s burst %d", rate, burst)
+}
+
+func accessLogDestination(s *apigwTypes.AccessLogSettings) string {
+	if s == nil {
+		return ""
+	}
+	return logGroupName(aws.ToString(s.DestinationArn))
+}
+
+// logGroupName returns the log group of an access log destination arn, the
+// arn itself for Firehose destinations.
+func logGroupName(arn string) string {
+	if i := strings.Index(arn, ":log-group:"); i >= 0 {
+		return strings.TrimSuffix(arn[i+len(":log-group:"):], ":*")
+	}
+	return arn
+}
+
+// ListApiRoutes returns the methods of the resources of a REST API or the
+// routes of an HTTP or WebSocket API, along with their integrations.
+func ListApiRoutes(cfg aws.Config, protocol, apiId string) ([]ApiRouteResp, error) {
+	if protocol == ApiProtocolRest {
+		return listRestRoutes(cfg, apiId)
+	}
+	return listHttpRoutes(cfg, apiId)
+}
+
+func listRestRoutes(cfg aws.Config, apiId string) ([]ApiRouteResp, error) {
+	var routes []ApiRouteResp
+	paginator := apigateway.NewGetResourcesPaginator(apigateway.NewFromConfig(cfg), &apigateway.GetResourcesInput{
+		RestApiId: &apiId,
+		Embed:     []string{"methods"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting resources of rest api %v, err: %v", apiId, err))
+			return nil, err
+		}
+		for _, r := range output.Items {
+			routes = append(routes, restRoutes(r)...)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Route < routes[j].Route
+	})
+	return routes, nil
+}
+
+func restRoutes(r apigwTypes.Resource) []ApiRouteResp {
+	routes := make([]ApiRouteResp, 0, len(r.ResourceMethods))
+	for verb, m := range r.ResourceMethods {
+		route := ApiRouteResp{
+			Route:         verb + " " + aws.ToString(r.Path),
+			Authorization: aws.ToString(m.AuthorizationType),
+			Raw:           m,
+		}
+		if i := m.MethodIntegration; i != nil {
+			route.Integration = string(i.Type)
+			route.Target = integrationTarget(aws.ToString(i.Uri))
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func listHttpRoutes(cfg aws.Config, apiId string) ([]ApiRouteResp, error) {
+	client := apigatewayv2.NewFromConfig(cfg)
+	integrations := make(map[string]apigwv2Types.Integration)
+	integrationsInput := &apigatewayv2.GetIntegrationsInput{ApiId: &apiId}
+	for {
+		output, err := client.GetIntegrations(context.TODO(), integrationsInput)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting integrations of api %v, err: %v", apiId, err))
+			return nil, err
+		}
+		for _, i := range output.Items {
+			integrations[aws.ToString(i.IntegrationId)] = i
+		}
+		if output.NextToken == nil {
+			break
+		}
+		integrationsInput.NextToken = output.NextToken
+	}
+
+	var routes []ApiRouteResp
+	routesInput := &apigatewayv2.GetRoutesInput{ApiId: &apiId}
+	for {
+		output, err := client.GetRoutes(context.TODO(), routesInput)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting routes of api %v, err: %v", apiId, err))
+			return nil, err
+		}
+		for _, r := range output.Items {
+			routes = append(routes, httpRoute(r, integrations))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		routesInput.NextToken = output.NextToken
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Route < routes[j].Route
+	})
+	return routes, nil
+}
+
+func httpRoute(r apigwv2Types.Route, integrations map[string]apigwv2Types.Integration) ApiRouteResp {
+	route := ApiRouteResp{
+		Route:         aws.ToString(r.RouteKey),
+		Authorization: string(r.AuthorizationType),
+		Raw:           r,
+	}
+	// Targets are of the form integrations/id.
+	id := strings.TrimPrefix(aws.ToString(r.Target), "integrations/")
+	if i, ok := integrations[id]; ok {
+		route.Integration = string(i.IntegrationType)
+		route.Target = integrationTarget(aws.ToString(i.IntegrationUri))
+		route.Raw = struct {
+			Route       apigwv2Types.Route
+			Integration apigwv2Types.Integration
+		}{r, i}
+	}
+	return route
+}
+
+// integrationTarget returns lambda:name for integrations invoking a Lambda
+// function, the integration uri otherwise.
+func integrationTarget(uri string) string {
+	if name := LambdaFunctionName(uri); name != "" {
+		return "lambda:" + name
+	}
+	return uri
+}
+
+// LambdaFunctionName returns the name of the function invoked by an
+// integration uri, either a function arn or an API Gateway invocation arn.
+func LambdaFunctionName(uri string) string {
+	i := strings.Index(uri, ":function:")
+	if i < 0 {
+		return ""
+	}
+	name := uri[i+len(":function:"):]
+	if j := strings.IndexAny(name, "/:"); j >= 0 {
+		name = name[:j]
+	}
+	return name
+}
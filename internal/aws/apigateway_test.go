@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	apigwTypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	apigwv2Types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+func TestLambdaFunctionName(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:123456789012:function:list-orders/invocations":      "list-orders",
+		"arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:123456789012:function:list-orders:live/invocations": "list-orders",
+		"arn:aws:lambda:us-east-1:123456789012:function:list-orders":                                                                                "list-orders",
+		"https://example.com/orders": "",
+	}
+	for uri, want := range tests {
+		if got := LambdaFunctionName(uri); got != want {
+			t.Errorf("LambdaFunctionName(%q) = %q, want %q", uri, got, want)
+		}
+	}
+}
+
+func TestRestStage(t *testing.T) {
+	stage := apigwTypes.Stage{
+		StageName:    aws.String("prod"),
+		DeploymentId: aws.String("d1e2f3"),
+		MethodSettings: map[string]apigwTypes.MethodSetting{
+			"*/*": {ThrottlingRateLimit: 100, ThrottlingBurstLimit: 50, LoggingLevel: aws.String("INFO")},
+		},
+		AccessLogSettings: &apigwTypes.AccessLogSettings{DestinationArn: aws.String("arn:aws:logs:us-east-1:123456789012:log-group:/aws/apigw/orders:*")},
+	}
+	want := ApiStageResp{
+		Stage:      "prod",
+		Deployment: "d1e2f3",
+		Throttling: "100/s burst 50",
+		Logging:    "INFO",
+		AccessLog:  "/aws/apigw/orders",
+		InvokeUrl:  "https://a1b2c3.execute-api.us-east-1.amazonaws.com/prod",
+		Raw:        stage,
+	}
+	if got := restStage(stage, "us-east-1", "a1b2c3"); !reflect.DeepEqual(got, want) {
+		t.Errorf("restStage() = %+v, want %+v", got, want)
+	}
+}
+
+func TestHttpStageInvokeUrl(t *testing.T) {
+	endpoint := "https://a1b2c3.execute-api.us-east-1.amazonaws.com"
+	tests := map[string]string{
+		"$default": endpoint,
+		"prod":     endpoint + "/prod",
+	}
+	for name, want := range tests {
+		stage := httpStage(apigwv2Types.Stage{StageName: aws.String(name)}, endpoint)
+		if stage.InvokeUrl != want {
+			t.Errorf("httpStage(%q).InvokeUrl = %q, want %q", name, stage.InvokeUrl, want)
+		}
+	}
+}
+
+func TestHttpRoute(t *testing.T) {
+	integrations := map[string]apigwv2Types.Integration{
+		"abc": {
+			IntegrationId:   aws.String("abc"),
+			IntegrationType: apigwv2Types.IntegrationTypeAwsProxy,
+			IntegrationUri:  aws.String("arn:aws:lambda:us-east-1:123456789012:function:list-orders"),
+		},
+	}
+	route := httpRoute(apigwv2Types.Route{
+		RouteKey:          aws.String("GET /orders"),
+		Target:            aws.String("integrations/abc"),
+		AuthorizationType: apigwv2Types.AuthorizationTypeJwt,
+	}, integrations)
+	if route.Route != "GET /orders" || route.Integration != "AWS_PROXY" || route.Target != "lambda:list-orders" || route.Authorization != "JWT" {
+		t.Errorf("httpRoute() = %+v", route)
+	}
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/rs/zerolog/log"
+)
+
+// AsgProcesses are the scaling processes that can be suspended and resumed.
+var AsgProcesses = []string{
+	"Launch", "Terminate", "AddToLoadBalancer", "AlarmNotification", "AZRebalance",
+	"HealthCheck", "InstanceRefresh", "ReplaceUnhealthy", "ScheduledActions",
+}
+
+// ListAutoScalingGroups returns the auto scaling groups of the region.
+func ListAutoScalingGroups(cfg aws.Config) ([]AsgResp, error) {
+	var groups []AsgResp
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(autoscaling.NewFromConfig(cfg), &autoscaling.DescribeAutoScalingGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing auto scaling groups, err: %v", err))
+			return nil, err
+		}
+		for _, g := range output.AutoScalingGroups {
+			groups = append(groups, AsgResp{
+				Name:               aws.ToString(g.AutoScalingGroupName),
+				Min:                strconv.Itoa(int(aws.ToInt32(g.MinSize))),
+				Desired:            strconv.Itoa(int(aws.ToInt32(g.DesiredCapacity))),
+				Max:                strconv.Itoa(int(aws.ToInt32(g.MaxSize))),
+				Instances:          strconv.Itoa(len(g.Instances)),
+				LaunchTemplate:     asgLaunchTemplate(g),
+				HealthCheckType:    aws.ToString(g.HealthCheckType),
+				SuspendedProcesses: suspendedProcesses(g.SuspendedProcesses),
+				CreatedAt:          ecsLocalTime(g.CreatedTime),
+				Arn:                aws.ToString(g.AutoScalingGroupARN),
+			})
+		}
+	}
+	return groups, nil
+}
+
+// asgLaunchTemplate returns the launch template and version of a group i.e
+// web:$Latest, falling back to its launch configuration.
+func asgLaunchTemplate(g asgTypes.AutoScalingGroup) string {
+	lt := g.LaunchTemplate
+	if lt == nil && g.MixedInstancesPolicy != nil && g.MixedInstancesPolicy.LaunchTemplate != nil {
+		lt = g.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if lt == nil {
+		return aws.ToString(g.LaunchConfigurationName)
+	}
+	name := aws.ToString(lt.LaunchTemplateName)
+	if name == "" {
+		name = aws.ToString(lt.LaunchTemplateId)
+	}
+	if lt.Version == nil {
+		return name
+	}
+	return name + ":" + *lt.Version
+}
+
+func suspendedProcesses(pp []asgTypes.SuspendedProcess) string {
+	names := make([]string, 0, len(pp))
+	for _, p := range pp {
+		names = append(names, aws.ToString(p.ProcessName))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// GetAutoScalingGroup describes an auto scaling group.
+func GetAutoScalingGroup(cfg aws.Config, name string) (*asgTypes.AutoScalingGroup, error) {
+	output, err := autoscaling.NewFromConfig(cfg).DescribeAutoScalingGroups(context.TODO(), &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing auto scaling group %v, err: %v", name, err))
+		return nil, err
+	}
+	if len(output.AutoScalingGroups) == 0 {
+		return nil, fmt.Errorf("auto scaling group %s not found", name)
+	}
+	return &output.AutoScalingGroups[0], nil
+}
+
+// ListAsgInstances returns the member instances of an auto scaling group
+// along with their lifecycle state.
+func ListAsgInstances(cfg aws.Config, name string) ([]AsgInstanceResp, error) {
+	g, err := GetAutoScalingGroup(cfg, name)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(g.Instances))
+	members := make(map[string]asgTypes.Instance, len(g.Instances))
+	for i, ins := range g.Instances {
+		ids[i] = aws.ToString(ins.InstanceId)
+		members[ids[i]] = ins
+	}
+	instances, err := GetInstancesByIds(cfg, ids)
+	if err != nil {
+		return nil, err
+	}
+	resps := make([]AsgInstanceResp, len(instances))
+	for i, ins := range instances {
+		member := members[ins.InstanceId]
+		resps[i] = AsgInstanceResp{
+			EC2Resp:        ins,
+			LifecycleState: string(member.LifecycleState),
+			HealthStatus:   aws.ToString(member.HealthStatus),
+		}
+	}
+	return resps, nil
+}
+
+// ListScalingActivities returns the scaling activity history of an auto scaling group.
+func ListScalingActivities(cfg aws.Config, name string) ([]AsgActivityResp, error) {
+	var activities []AsgActivityResp
+	paginator := autoscaling.NewDescribeScalingActivitiesPaginator(autoscaling.NewFromConfig(cfg), &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: &name,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing scaling activities of %v, err: %v", name, err))
+			return nil, err
+		}
+		for _, a := range output.Activities {
+			activities = append(activities, AsgActivityResp{
+				Id:            aws.ToString(a.ActivityId),
+				Status:        string(a.StatusCode),
+				StartTime:     ecsLocalTime(a.StartTime),
+				EndTime:       ecsLocalTime(a.EndTime),
+				Description:   aws.ToString(a.Description),
+				StatusMessage: aws.ToString(a.StatusMessage),
+				Cause:         aws.ToString(a.Cause),
+			})
+		}
+	}
+	return activities, nil
+}
+
+// GetScalingActivity describes a scaling activity.
+func GetScalingActivity(cfg aws.Config, name, id string) (*asgTypes.Activity, error) {
+	output, err := autoscaling.NewFromConfig(cfg).DescribeScalingActivities(context.TODO(), &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: &name,
+		ActivityIds:          []string{id},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing scaling activity %v, err: %v", id, err))
+		return nil, err
+	}
+	if len(output.Activities) == 0 {
+		return nil, fmt.Errorf("scaling activity %s not found", id)
+	}
+	return &output.Activities[0], nil
+}
+
+// SetAsgDesiredCapacity sets the desired capacity of an auto scaling group.
+func SetAsgDesiredCapacity(cfg aws.Config, name string, desired int32) error {
+	_, err := autoscaling.NewFromConfig(cfg).SetDesiredCapacity(context.TODO(), &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: &name,
+		DesiredCapacity:      &desired,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error setting desired capacity of %v, err: %v", name, err))
+	}
+	return err
+}
+
+// StartInstanceRefresh starts a rolling instance refresh and returns its id.
+func StartInstanceRefresh(cfg aws.Config, name string, minHealthyPercentage int32) (string, error) {
+	output, err := autoscaling.NewFromConfig(cfg).StartInstanceRefresh(context.TODO(), &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: &name,
+		Strategy:             asgTypes.RefreshStrategyRolling,
+		Preferences:          &asgTypes.RefreshPreferences{MinHealthyPercentage: &minHealthyPercentage},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error starting instance refresh of %v, err: %v", name, err))
+		return "", err
+	}
+	return aws.ToString(output.InstanceRefreshId), nil
+}
+
+// CancelInstanceRefresh cancels the in progress instance refresh and returns its id.
+func CancelInstanceRefresh(cfg aws.Config, name string) (string, error) {
+	output, err := autoscaling.NewFromConfig(cfg).CancelInstanceRefresh(context.TODO(), &autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error cancelling instance refresh of %v, err: %v", name, err))
+		return "", err
+	}
+	return aws.ToString(output.InstanceRefreshId), nil
+}
+
+// SuspendAsgProcesses suspends the given scaling processes, all of them when empty.
+func SuspendAsgProcesses(cfg aws.Config, name string, processes []string) error {
+	_, err := autoscaling.NewFromConfig(cfg).SuspendProcesses(context.TODO(), &autoscaling.SuspendProcessesInput{
+		AutoScalingGroupName: &name,
+		ScalingProcesses:     processes,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error suspending processes of %v, err: %v", name, err))
+	}
+	return err
+}
+
+// ResumeAsgProcesses resumes the given scaling processes, all of them when empty.
+func ResumeAsgProcesses(cfg aws.Config, name string, processes []string) error {
+	_, err := autoscaling.NewFromConfig(cfg).ResumeProcesses(context.TODO(), &autoscaling.ResumeProcessesInput{
+		AutoScalingGroupName: &name,
+		ScalingProcesses:     processes,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error resuming processes of %v, err: %v", name, err))
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	asgTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+)
+
+func TestAsgLaunchTemplate(t *testing.T) {
+	uu := map[string]struct {
+		group asgTypes.AutoScalingGroup
+		want  string
+	}{
+		"template": {
+			group: asgTypes.AutoScalingGroup{LaunchTemplate: &asgTypes.LaunchTemplateSpecification{LaunchTemplateName: aws.String("web"), Version: aws.String("$Latest")}},
+			want:  "web:$Latest",
+		},
+		"mixed": {
+			group: asgTypes.AutoScalingGroup{MixedInstancesPolicy: &asgTypes.MixedInstancesPolicy{LaunchTemplate: &asgTypes.LaunchTemplate{
+				LaunchTemplateSpecification: &asgTypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("3")},
+			}}},
+			want: "lt-1:3",
+		},
+		"configuration": {
+			group: asgTypes.AutoScalingGroup{LaunchConfigurationName: aws.String("legacy")},
+			want:  "legacy",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := asgLaunchTemplate(u.group); got != u.want {
+				t.Errorf("asgLaunchTemplate() = %q, want %q", got, u.want)
+			}
+		})
+	}
+}
+
+func TestSuspendedProcesses(t *testing.T) {
+	pp := []asgTypes.SuspendedProcess{{ProcessName: aws.String("Terminate")}, {ProcessName: aws.String("AZRebalance")}}
+	if got, want := suspendedProcesses(pp), "AZRebalance,Terminate"; got != want {
+		t.Errorf("suspendedProcesses() = %q, want %q", got, want)
+	}
+}
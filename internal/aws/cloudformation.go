@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListStacks returns the stacks of the region, deleted stacks excluded.
+func ListStacks(cfg aws.Config) ([]StackResp, error) {
+	var stacks []StackResp
+	paginator := cloudformation.NewDescribeStacksPaginator(cloudformation.NewFromConfig(cfg), &cloudformation.DescribeStacksInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing stacks, err: %v", err))
+			return nil, err
+		}
+		for _, s := range output.Stacks {
+			drift := string(cfnTypes.StackDriftStatusNotChecked)
+			if s.DriftInformation != nil {
+				drift = string(s.DriftInformation.StackDriftStatus)
+			}
+			stacks = append(stacks, StackResp{
+				Name:        aws.ToString(s.StackName),
+				Status:      string(s.StackStatus),
+				Drift:       drift,
+				CreatedAt:   ecsLocalTime(s.CreationTime),
+				UpdatedAt:   ecsLocalTime(s.LastUpdatedTime),
+				Description: aws.ToString(s.Description),
+			})
+		}
+	}
+	return stacks, nil
+}
+
+// GetStack describes a stack.
+func GetStack(cfg aws.Config, name string) (*cfnTypes.Stack, error) {
+	output, err := cloudformation.NewFromConfig(cfg).DescribeStacks(context.TODO(), &cloudformation.DescribeStacksInput{
+		StackName: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing stack %v, err: %v", name, err))
+		return nil, err
+	}
+	if len(output.Stacks) == 0 {
+		return nil, fmt.Errorf("stack %s not found", name)
+	}
+	return &output.Stacks[0], nil
+}
+
+// GetStackOutputs returns the outputs and parameters of a stack as text.
+func GetStackOutputs(cfg aws.Config, name string) (string, error) {
+	stack, err := GetStack(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return renderStackOutputs(stack), nil
+}
+
+func renderStackOutputs(stack *cfnTypes.Stack) string {
+	var b strings.Builder
+	b.WriteString("Outputs:\n")
+	if len(stack.Outputs) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, o := range stack.Outputs {
+		fmt.Fprintf(&b, "  %s = %s", aws.ToString(o.OutputKey), aws.ToString(o.OutputValue))
+		if o.ExportName != nil {
+			fmt.Fprintf(&b, " (export %s)", *o.ExportName)
+		}
+		if o.Description != nil {
+			fmt.Fprintf(&b, "  # %s", *o.Description)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\nParameters:\n")
+	if len(stack.Parameters) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, p := range stack.Parameters {
+		fmt.Fprintf(&b, "  %s = %s", aws.ToString(p.ParameterKey), aws.ToString(p.ParameterValue))
+		if p.ResolvedValue != nil {
+			fmt.Fprintf(&b, " (resolved %s)", *p.ResolvedValue)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ListStackResources returns the resources of a stack.
+func ListStackResources(cfg aws.Config, stack string) ([]StackResourceResp, error) {
+	var resources []StackResourceResp
+	paginator := cloudformation.NewListStackResourcesPaginator(cloudformation.NewFromConfig(cfg), &cloudformation.ListStackResourcesInput{
+		StackName: &stack,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing resources of stack %v, err: %v", stack, err))
+			return nil, err
+		}
+		for _, r := range output.StackResourceSummaries {
+			drift := string(cfnTypes.StackResourceDriftStatusNotChecked)
+			if r.DriftInformation != nil {
+				drift = string(r.DriftInformation.StackResourceDriftStatus)
+			}
+			resources = append(resources, StackResourceResp{
+				LogicalId:  aws.ToString(r.LogicalResourceId),
+				PhysicalId: aws.ToString(r.PhysicalResourceId),
+				Type:       aws.ToString(r.ResourceType),
+				Status:     string(r.ResourceStatus),
+				Drift:      drift,
+				UpdatedAt:  ecsLocalTime(r.LastUpdatedTimestamp),
+				Reason:     aws.ToString(r.ResourceStatusReason),
+			})
+		}
+	}
+	return resources, nil
+}
+
+// GetStackResource describes a stack resource.
+func GetStackResource(cfg aws.Config, stack, logicalId string) (*cfnTypes.StackResourceDetail, error) {
+	output, err := cloudformation.NewFromConfig(cfg).DescribeStackResource(context.TODO(), &cloudformation.DescribeStackResourceInput{
+		StackName:         &stack,
+		LogicalResourceId: &logicalId,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing resource %v of stack %v, err: %v", logicalId, stack, err))
+		return nil, err
+	}
+	return output.StackResourceDetail, nil
+}
+
+// stackEventsMaxPages caps the pages of events fetched per listing, stacks
+// with a long history having thousands of events.
+const stackEventsMaxPages = 5
+
+// ListStackEvents returns the events of a stack, most recent first. Only the
+// events newer than the cached ones are fetched, the cache being replaced
+// when they are too many to reach it.
+func ListStackEvents(cfg aws.Config, stack string, cached []StackEventResp) ([]StackEventResp, error) {
+	return listStackEvents(context.TODO(), cloudformation.NewFromConfig(cfg), stack, cached)
+}
+
+func listStackEvents(ctx context.Context, api cloudformation.DescribeStackEventsAPIClient, stack string, cached []StackEventResp) ([]StackEventResp, error) {
+	var since string
+	if len(cached) > 0 {
+		since = cached[0].Id
+	}
+	var events []StackEventResp
+	paginator := cloudformation.NewDescribeStackEventsPaginator(api, &cloudformation.DescribeStackEventsInput{
+		StackName: &stack,
+	})
+	for page := 0; page < stackEventsMaxPages && paginator.HasMorePages(); page++ {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing events of stack %v, err: %v", stack, err))
+			return nil, err
+		}
+		for _, e := range output.StackEvents {
+			if since != "" && aws.ToString(e.EventId) == since {
+				return append(events, cached...), nil
+			}
+			events = append(events, StackEventResp{
+				Id:        aws.ToString(e.EventId),
+				Timestamp: ecsLocalTime(e.Timestamp),
+				LogicalId: aws.ToString(e.LogicalResourceId),
+				Type:      aws.ToString(e.ResourceType),
+				Status:    string(e.ResourceStatus),
+				Reason:    aws.ToString(e.ResourceStatusReason),
+				Raw:       e,
+			})
+		}
+	}
+	return events, nil
+}
+
+// DetectStackDrift starts drift detection of a stack and returns the detection id.
+func DetectStackDrift(cfg aws.Config, stack string) (string, error) {
+	output, err := cloudformation.NewFromConfig(cfg).DetectStackDrift(context.TODO(), &cloudformation.DetectStackDriftInput{
+		StackName: &stack,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error detecting drift of stack %v, err: %v", stack, err))
+		return "", err
+	}
+	return aws.ToString(output.StackDriftDetectionId), nil
+}
+
+// GetStackResourceDrifts returns the drift of the resources of a stack as
+// unified diffs of their expected and actual properties. All resources are
+// considered when logicalId is empty.
+func GetStackResourceDrifts(cfg aws.Config, stack, logicalId string) (string, error) {
+	var drifts []cfnTypes.StackResourceDrift
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(cloudformation.NewFromConfig(cfg), &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: &stack,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing resource drifts of stack %v, err: %v", stack, err))
+			return "", err
+		}
+		for _, d := range output.StackResourceDrifts {
+			if logicalId == "" || aws.ToString(d.LogicalResourceId) == logicalId {
+				drifts = append(drifts, d)
+			}
+		}
+	}
+	return renderResourceDrifts(drifts)
+}
+
+func renderResourceDrifts(drifts []cfnTypes.StackResourceDrift) (string, error) {
+	if len(drifts) == 0 {
+		return "No drift results, run drift detection first", nil
+	}
+	var b strings.Builder
+	for _, d := range drifts {
+		logicalId := aws.ToString(d.LogicalResourceId)
+		fmt.Fprintf(&b, "%s (%s): %s\n", logicalId, aws.ToString(d.ResourceType), d.StackResourceDriftStatus)
+		if d.StackResourceDriftStatus != cfnTypes.StackResourceDriftStatusModified {
+			b.WriteString("\n")
+			continue
+		}
+		for _, p := range d.PropertyDifferences {
+			fmt.Fprintf(&b, "  %s %s: %s -> %s\n", p.DifferenceType, aws.ToString(p.PropertyPath), aws.ToString(p.ExpectedValue), aws.ToString(p.ActualValue))
+		}
+		var expected, actual interface{}
+		if err := json.Unmarshal([]byte(aws.ToString(d.ExpectedProperties)), &expected); err != nil {
+			return "", fmt.Errorf("failed to parse expected properties of %s: %w", logicalId, err)
+		}
+		if err := json.Unmarshal([]byte(aws.ToString(d.ActualProperties)), &actual); err != nil {
+			return "", fmt.Errorf("failed to parse actual properties of %s: %w", logicalId, err)
+		}
+		diff, err := jsonDiff(logicalId+" (expected)", logicalId+" (actual)", expected, actual)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(diff)
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestRenderStackOutputs(t *testing.T) {
+	stack := cfnTypes.Stack{
+		Outputs: []cfnTypes.Output{
+			{OutputKey: aws.String("Url"), OutputValue: aws.String("https://example.com"), ExportName: aws.String("web-url"), Description: aws.String("site")},
+		},
+	}
+	got := renderStackOutputs(&stack)
+	want := "Outputs:\n  Url = https://example.com (export web-url)  # site\n\nParameters:\n  none\n"
+	if got != want {
+		t.Errorf("renderStackOutputs() = %q, want %q", got, want)
+	}
+
+	stack.Parameters = []cfnTypes.Parameter{{ParameterKey: aws.String("Ami"), ParameterValue: aws.String("/ami/latest"), ResolvedValue: aws.String("ami-123")}}
+	if got := renderStackOutputs(&stack); !strings.Contains(got, "  Ami = /ami/latest (resolved ami-123)\n") {
+		t.Errorf("renderStackOutputs() = %q, missing resolved parameter", got)
+	}
+}
+
+func TestRenderResourceDrifts(t *testing.T) {
+	got, err := renderResourceDrifts(nil)
+	if err != nil || got != "No drift results, run drift detection first" {
+		t.Errorf("renderResourceDrifts(nil) = %q, %v", got, err)
+	}
+
+	drifts := []cfnTypes.StackResourceDrift{
+		{LogicalResourceId: aws.String("Bucket"), ResourceType: aws.String("AWS::S3::Bucket"), StackResourceDriftStatus: cfnTypes.StackResourceDriftStatusInSync},
+		{
+			LogicalResourceId:        aws.String("Queue"),
+			ResourceType:             aws.String("AWS::SQS::Queue"),
+			StackResourceDriftStatus: cfnTypes.StackResourceDriftStatusModified,
+			ExpectedProperties:       aws.String(`{"VisibilityTimeout":30}`),
+			ActualProperties:         aws.String(`{"VisibilityTimeout":60}`),
+			PropertyDifferences: []cfnTypes.PropertyDifference{
+				{DifferenceType: cfnTypes.DifferenceTypeNotEqual, PropertyPath: aws.String("/VisibilityTimeout"), ExpectedValue: aws.String("30"), ActualValue: aws.String("60")},
+			},
+		},
+	}
+	got, err = renderResourceDrifts(drifts)
+	if err != nil {
+		t.Fatalf("renderResourceDrifts() err = %v", err)
+	}
+	for _, s := range []string{
+		"Bucket (AWS::S3::Bucket): IN_SYNC\n",
+		"Queue (AWS::SQS::Queue): MODIFIED\n",
+		"  NOT_EQUAL /VisibilityTimeout: 30 -> 60\n",
+		"-  \"VisibilityTimeout\": 30",
+		"+  \"VisibilityTimeout\": 60",
+	} {
+		if !strings.Contains(got, s) {
+			t.Errorf("renderResourceDrifts() = %q, missing %q", got, s)
+		}
+	}
+}
+
+// mockStackEventsAPI pages events newest first, two per page.
+type mockStackEventsAPI struct {
+	ids   []string
+	calls int
+}
+
+func (m *mockStackEventsAPI) DescribeStackEvents(ctx context.Context, params *cloudformation.DescribeStackEventsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackEventsOutput, error) {
+	m.calls++
+	start := 0
+	if params.NextToken != nil {
+		fmt.Sscan(*params.NextToken, &start)
+	}
+	end := start + 2
+	if end > len(m.ids) {
+		end = len(m.ids)
+	}
+	output := &cloudformation.DescribeStackEventsOutput{}
+	for _, id := range m.ids[start:end] {
+		output.StackEvents = append(output.StackEvents, cfnTypes.StackEvent{EventId: aws.String(id)})
+	}
+	if end < len(m.ids) {
+		output.NextToken = aws.String(fmt.Sprint(end))
+	}
+	return output, nil
+}
+
+func TestListStackEvents(t *testing.T) {
+	var history []string
+	for i := 30; i > 0; i-- {
+		history = append(history, fmt.Sprintf("e%d", i))
+	}
+	uu := map[string]struct {
+		ids    []string
+		cached []string
+		events []string
+		calls  int
+	}{
+		"first": {ids: history[:3], events: history[:3], calls: 2},
+		"capped": {
+			ids:    history,
+			events: history[:2*stackEventsMaxPages],
+			calls:  stackEventsMaxPages,
+		},
+		"since": {
+			ids:    history,
+			cached: history[3:6],
+			events: history[:6],
+			calls:  2,
+		},
+		"unchanged": {
+			ids:    history[3:],
+			cached: history[3:6],
+			events: history[3:6],
+			calls:  1,
+		},
+		"gap": {
+			ids:    history,
+			cached: history[20:22],
+			events: history[:2*stackEventsMaxPages],
+			calls:  stackEventsMaxPages,
+		},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var cached []StackEventResp
+			for _, id := range u.cached {
+				cached = append(cached, StackEventResp{Id: id})
+			}
+			api := mockStackEventsAPI{ids: u.ids}
+			events, err := listStackEvents(context.TODO(), &api, "web", cached)
+			if err != nil {
+				t.Fatalf("listStackEvents() err = %v", err)
+			}
+			var ids []string
+			for _, e := range events {
+				ids = append(ids, e.Id)
+			}
+			if strings.Join(ids, ",") != strings.Join(u.events, ",") {
+				t.Errorf("listStackEvents() = %v, want %v", ids, u.events)
+			}
+			if api.calls != u.calls {
+				t.Errorf("listStackEvents() calls = %d, want %d", api.calls, u.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultPathPattern is the path pattern shown for the default cache behavior.
+const DefaultPathPattern = "Default (*)"
+
+// InvalidationCompleted is the status of a finished invalidation.
+const InvalidationCompleted = "Completed"
+
+func ListDistributions(cfg aws.Config) ([]DistributionResp, error) {
+	var distributions []DistributionResp
+	paginator := cloudfront.NewListDistributionsPaginator(cloudfront.NewFromConfig(cfg), &cloudfront.ListDistributionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting distributions, err: %v", err))
+			return nil, err
+		}
+		if output.DistributionList == nil {
+			continue
+		}
+		for _, d := range output.DistributionList.Items {
+			distributions = append(distributions, distribution(d))
+		}
+	}
+	return distributions, nil
+}
+
+func distribution(d cfTypes.DistributionSummary) DistributionResp {
+	var aliases, origins []string
+	if d.Aliases != nil {
+		aliases = d.Aliases.Items
+	}
+	if d.Origins != nil {
+		for _, o := range d.Origins.Items {
+			origins = append(origins, aws.ToString(o.DomainName))
+		}
+	}
+	return DistributionResp{
+		Id:         aws.ToString(d.Id),
+		Domain:     aws.ToString(d.DomainName),
+		Aliases:    strings.Join(aliases, ","),
+		Status:     aws.ToString(d.Status),
+		PriceClass: string(d.PriceClass),
+		Origins:    strings.Join(origins, ","),
+		Comment:    aws.ToString(d.Comment),
+		Raw:        d,
+	}
+}
+
+// ListCacheBehaviors returns the cache behaviors of a distribution in
+// precedence order, the default behavior coming last.
+func ListCacheBehaviors(cfg aws.Config, id string) ([]CacheBehaviorResp, error) {
+	output, err := cloudfront.NewFromConfig(cfg).GetDistributionConfig(context.TODO(), &cloudfront.GetDistributionConfigInput{
+		Id: &id,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting config of distribution %v, err: %v", id, err))
+		return nil, err
+	}
+	return cacheBehaviors(output.DistributionConfig), nil
+}
+
+func cacheBehaviors(c *cfTypes.DistributionConfig) []CacheBehaviorResp {
+	if c == nil {
+		return nil
+	}
+	var behaviors []CacheBehaviorResp
+	if c.CacheBehaviors != nil {
+		for i, b := range c.CacheBehaviors.Items {
+			behaviors = append(behaviors, CacheBehaviorResp{
+				PathPattern:    aws.ToString(b.PathPattern),
+				Precedence:     strconv.Itoa(i),
+				Origin:         aws.ToString(b.TargetOriginId),
+				ViewerProtocol: string(b.ViewerProtocolPolicy),
+				Methods:        allowedMethods(b.AllowedMethods),
+				CachePolicy:    cachePolicy(b.CachePolicyId),
+				Compress:       strconv.FormatBool(aws.ToBool(b.Compress)),
+				Raw:            b,
+			})
+		}
+	}
+	if b := c.DefaultCacheBehavior; b != nil {
+		behaviors = append(behaviors, CacheBehaviorResp{
+			PathPattern:    DefaultPathPattern,
+			Precedence:     strconv.Itoa(len(behaviors)),
+			Origin:         aws.ToString(b.TargetOriginId),
+			ViewerProtocol: string(b.ViewerProtocolPolicy),
+			Methods:        allowedMethods(b.AllowedMethods),
+			CachePolicy:    cachePolicy(b.CachePolicyId),
+			Compress:       strconv.FormatBool(aws.ToBool(b.Compress)),
+			Raw:            b,
+		})
+	}
+	return behaviors
+}
+
+func allowedMethods(m *cfTypes.AllowedMethods) string {
+	if m == nil {
+		return ""
+	}
+	methods := make([]string, len(m.Items))
+	for i, method := range m.Items {
+		methods[i] = string(method)
+	}
+	return strings.Join(methods, ",")
+}
+
+// cachePolicy returns the cache policy id of a behavior, legacy for the ones
+// still configured with forwarded values and TTLs.
+func cachePolicy(id *string) string {
+	if id == nil {
+		return "legacy"
+	}
+	return *id
+}
+
+// CreateInvalidation invalidates the given paths of a distribution and
+// returns the id of the invalidation.
+func CreateInvalidation(cfg aws.Config, id string, paths []string) (string, error) {
+	output, err := cloudfront.NewFromConfig(cfg).CreateInvalidation(context.TODO(), &cloudfront.CreateInvalidationInput{
+		DistributionId: &id,
+		InvalidationBatch: &cfTypes.InvalidationBatch{
+			CallerReference: aws.String(fmt.Sprintf("cloudlens-%d", time.Now().UnixNano())),
+			Paths: &cfTypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error creating invalidation of distribution %v, err: %v", id, err))
+		return "", err
+	}
+	return aws.ToString(output.Invalidation.Id), nil
+}
+
+// InvalidationPaths splits comma or space separated paths, making them
+// absolute as CloudFront requires.
+func InvalidationPaths(paths string) []string {
+	items := strings.FieldsFunc(paths, func(r rune) bool { return r == ',' || r == ' ' })
+	for i, item := range items {
+		if !strings.HasPrefix(item, "/") {
+			items[i] = "/" + item
+		}
+	}
+	return items
+}
+
+// GetInvalidationStatus returns the status of an invalidation, either
+// InProgress or Completed.
+func GetInvalidationStatus(cfg aws.Config, distributionId, id string) (string, error) {
+	output, err := cloudfront.NewFromConfig(cfg).GetInvalidation(context.TODO(), &cloudfront.GetInvalidationInput{
+		DistributionId: &distributionId,
+		Id:             &id,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting invalidation %v of distribution %v, err: %v", id, distributionId, err))
+		return "", err
+	}
+	return aws.ToString(output.Invalidation.Status), nil
+}
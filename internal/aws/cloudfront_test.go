@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+func TestInvalidationPaths(t *testing.T) {
+	want := []string{"/index.html", "/images/*", "/css/*"}
+	if got := InvalidationPaths("index.html, /images/*  css/*"); !reflect.DeepEqual(got, want) {
+		t.Errorf("InvalidationPaths() = %v, want %v", got, want)
+	}
+	if got := InvalidationPaths(" , "); len(got) != 0 {
+		t.Errorf("InvalidationPaths() = %v, want no paths", got)
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	summary := cfTypes.DistributionSummary{
+		Id:         aws.String("E2QWRUHAPOMQZL"),
+		DomainName: aws.String("d111111abcdef8.cloudfront.net"),
+		Aliases:    &cfTypes.Aliases{Items: []string{"example.com", "www.example.com"}},
+		Status:     aws.String("Deployed"),
+		PriceClass: cfTypes.PriceClassPriceClass100,
+		Origins: &cfTypes.Origins{Items: []cfTypes.Origin{
+			{DomainName: aws.String("assets.s3.amazonaws.com")},
+			{DomainName: aws.String("api.example.com")},
+		}},
+	}
+	want := DistributionResp{
+		Id:         "E2QWRUHAPOMQZL",
+		Domain:     "d111111abcdef8.cloudfront.net",
+		Aliases:    "example.com,www.example.com",
+		Status:     "Deployed",
+		PriceClass: "PriceClass_100",
+		Origins:    "assets.s3.amazonaws.com,api.example.com",
+		Raw:        summary,
+	}
+	if got := distribution(summary); !reflect.DeepEqual(got, want) {
+		t.Errorf("distribution() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCacheBehaviors(t *testing.T) {
+	images := cfTypes.CacheBehavior{
+		PathPattern:          aws.String("/images/*"),
+		TargetOriginId:       aws.String("assets"),
+		ViewerProtocolPolicy: cfTypes.ViewerProtocolPolicyRedirectToHttps,
+		AllowedMethods:       &cfTypes.AllowedMethods{Items: []cfTypes.Method{cfTypes.MethodGet, cfTypes.MethodHead}},
+		Compress:             aws.Bool(true),
+	}
+	def := &cfTypes.DefaultCacheBehavior{
+		TargetOriginId:       aws.String("api"),
+		ViewerProtocolPolicy: cfTypes.ViewerProtocolPolicyHttpsOnly,
+		CachePolicyId:        aws.String("4135ea2d-6df8-44a3-9df3-4b5a84be39ad"),
+	}
+	got := cacheBehaviors(&cfTypes.DistributionConfig{
+		CacheBehaviors:       &cfTypes.CacheBehaviors{Items: []cfTypes.CacheBehavior{images}},
+		DefaultCacheBehavior: def,
+	})
+	want := []CacheBehaviorResp{
+		{PathPattern: "/images/*", Precedence: "0", Origin: "assets", ViewerProtocol: "redirect-to-https", Methods: "GET,HEAD", CachePolicy: "legacy", Compress: "true", Raw: images},
+		{PathPattern: DefaultPathPattern, Precedence: "1", Origin: "api", ViewerProtocol: "https-only", CachePolicy: "4135ea2d-6df8-44a3-9df3-4b5a84be39ad", Compress: "false", Raw: def},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cacheBehaviors() = %+v, want %+v", got, want)
+	}
+}
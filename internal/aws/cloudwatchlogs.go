@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultLogEventsLimit is the number of most recent log events fetched.
+const DefaultLogEventsLimit = 500
+
+// GetLogEvents returns the most recent events of a log stream, oldest first.
+func GetLogEvents(cfg aws.Config, group, stream string, limit int32) ([]LogEventResp, error) {
+	logsClient := cloudwatchlogs.NewFromConfig(cfg)
+	output, err := logsClient.GetLogEvents(context.TODO(), &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  &group,
+		LogStreamName: &stream,
+		Limit:         &limit,
+		StartFromHead: aws.Bool(false),
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting log events of %v/%v, err: %v", group, stream, err))
+		return nil, err
+	}
+	localZone, _ := config.GetLocalTimeZone()
+	loc, _ := time.LoadLocation(localZone)
+	events := make([]LogEventResp, len(output.Events))
+	for i, e := range output.Events {
+		events[i] = LogEventResp{
+			Timestamp: time.UnixMilli(aws.ToInt64(e.Timestamp)).In(loc).Format("Mon Jan _2 15:04:05 2006"),
+			Message:   aws.ToString(e.Message),
+		}
+	}
+	return events, nil
+}
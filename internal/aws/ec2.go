@@ -26,35 +26,68 @@ func GetInstances(cfg aws.Config) ([]EC2Resp, error) {
 	// Iterate through the instances and print their ID and state
 	for _, reservation := range resultec2.Reservations {
 		for _, instance := range reservation.Instances {
-			launchTime := instance.LaunchTime
-			localZone, err := config.GetLocalTimeZone() // Empty string loads the local timezone
+			ec2Resp, err := toEC2Resp(instance)
 			if err != nil {
-				fmt.Println("Error loading local timezone:", err)
 				return nil, err
 			}
-			loc, _ := time.LoadLocation(localZone)
-			IST := launchTime.In(loc)
+			ec2Info = append(ec2Info, ec2Resp)
+		}
+	}
+	return ec2Info, nil
+}
 
-			tags := map[string]string{}
-			for key := range instance.Tags {
-				tags[*instance.Tags[key].Key] = *instance.Tags[key].Value
+// GetInstancesByIds returns the instances with the given ids.
+func GetInstancesByIds(cfg aws.Config, ids []string) ([]EC2Resp, error) {
+	var ec2Info []EC2Resp
+	if len(ids) == 0 {
+		return ec2Info, nil
+	}
+	paginator := ec2.NewDescribeInstancesPaginator(ec2.NewFromConfig(cfg), &ec2.DescribeInstancesInput{InstanceIds: ids})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error fetching instances %v: %v", ids, err))
+			return nil, err
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				ec2Resp, err := toEC2Resp(instance)
+				if err != nil {
+					return nil, err
+				}
+				ec2Info = append(ec2Info, ec2Resp)
 			}
-
-			ec2Resp := &EC2Resp{
-				Name:             tags["Name"],
-				InstanceId:       *instance.InstanceId,
-				InstanceType:     string(instance.InstanceType),
-				AvailabilityZone: *instance.Placement.AvailabilityZone,
-				InstanceState:    string(instance.State.Name),
-				PublicDNS:        *instance.PublicDnsName,
-				MonitoringState:  string(instance.Monitoring.State),
-				LaunchTime:       IST.Format("Mon Jan _2 15:04:05 2006")}
-			ec2Info = append(ec2Info, *ec2Resp)
 		}
 	}
 	return ec2Info, nil
 }
 
+func toEC2Resp(instance types.Instance) (EC2Resp, error) {
+	launchTime := instance.LaunchTime
+	localZone, err := config.GetLocalTimeZone() // Empty string loads the local timezone
+	if err != nil {
+		fmt.Println("Error loading local timezone:", err)
+		return EC2Resp{}, err
+	}
+	loc, _ := time.LoadLocation(localZone)
+	IST := launchTime.In(loc)
+
+	tags := map[string]string{}
+	for key := range instance.Tags {
+		tags[*instance.Tags[key].Key] = *instance.Tags[key].Value
+	}
+
+	return EC2Resp{
+		Name:             tags["Name"],
+		InstanceId:       *instance.InstanceId,
+		InstanceType:     string(instance.InstanceType),
+		AvailabilityZone: *instance.Placement.AvailabilityZone,
+		InstanceState:    string(instance.State.Name),
+		PublicDNS:        *instance.PublicDnsName,
+		MonitoringState:  string(instance.Monitoring.State),
+		LaunchTime:       IST.Format("Mon Jan _2 15:04:05 2006")}, nil
+}
+
 func GetSingleInstance(cfg aws.Config, insId string) string {
 	ec2Client := ec2.NewFromConfig(cfg)
 	result, err := ec2Client.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{
@@ -68,6 +101,29 @@ func GetSingleInstance(cfg aws.Config, insId string) string {
 	return string(r)
 }
 
+// GetInstanceAddress returns the address an instance is reachable at,
+// preferring its public dns name over its public and private ips.
+func GetInstanceAddress(cfg aws.Config, insId string) (string, error) {
+	ec2Client := ec2.NewFromConfig(cfg)
+	result, err := ec2Client.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{
+		InstanceIds: []string{insId},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error fetching instance with id: %s, err: %v", insId, err))
+		return "", err
+	}
+	for _, r := range result.Reservations {
+		for _, i := range r.Instances {
+			for _, addr := range []*string{i.PublicDnsName, i.PublicIpAddress, i.PrivateIpAddress} {
+				if aws.ToString(addr) != "" {
+					return *addr, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("instance %s has no reachable address", insId)
+}
+
 func GetSecGrps(cfg aws.Config) ([]SGResp, error) {
 	var sgInfo []SGResp
 	ec2Client := ec2.NewFromConfig(cfg)
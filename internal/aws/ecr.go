@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/dustin/go-humanize"
+	"github.com/rs/zerolog/log"
+)
+
+// ecrMaxBatchDelete is the maximum number of images deleted per call.
+const ecrMaxBatchDelete = 100
+
+// ecrSeverities orders scan finding severities, most severe first.
+var ecrSeverities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNDEFINED"}
+
+func ListEcrRepositories(cfg aws.Config) ([]EcrRepositoryResp, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	var repos []EcrRepositoryResp
+	paginator := ecr.NewDescribeRepositoriesPaginator(ecrClient, &ecr.DescribeRepositoriesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing ECR repositories, err: %v", err))
+			return nil, err
+		}
+		for _, r := range output.Repositories {
+			var scanOnPush bool
+			if r.ImageScanningConfiguration != nil {
+				scanOnPush = r.ImageScanningConfiguration.ScanOnPush
+			}
+			repos = append(repos, EcrRepositoryResp{
+				Name:          aws.ToString(r.RepositoryName),
+				Uri:           aws.ToString(r.RepositoryUri),
+				ScanOnPush:    fmt.Sprint(scanOnPush),
+				TagMutability: string(r.ImageTagMutability),
+				CreatedAt:     ecsLocalTime(r.CreatedAt),
+				Arn:           aws.ToString(r.RepositoryArn),
+			})
+		}
+	}
+	return repos, nil
+}
+
+// CountEcrImages counts the distinct images of a repository, ListImages
+// returning an image id per tag.
+func CountEcrImages(cfg aws.Config, repo string) (int, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	digests := make(map[string]struct{})
+	paginator := ecr.NewListImagesPaginator(ecrClient, &ecr.ListImagesInput{RepositoryName: &repo})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing images of ECR repository %v, err: %v", repo, err))
+			return 0, err
+		}
+		for _, id := range output.ImageIds {
+			digests[aws.ToString(id.ImageDigest)] = struct{}{}
+		}
+	}
+	return len(digests), nil
+}
+
+func GetEcrRepository(cfg aws.Config, repo string) (*types.Repository, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	output, err := ecrClient.DescribeRepositories(context.TODO(), &ecr.DescribeRepositoriesInput{
+		RepositoryNames: []string{repo},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing ECR repository %v, err: %v", repo, err))
+		return nil, err
+	}
+	if len(output.Repositories) == 0 {
+		return nil, fmt.Errorf("repository %s not found", repo)
+	}
+	return &output.Repositories[0], nil
+}
+
+// ListEcrImages returns the images of a repository.
+func ListEcrImages(cfg aws.Config, repo string) ([]EcrImageResp, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	var images []EcrImageResp
+	paginator := ecr.NewDescribeImagesPaginator(ecrClient, &ecr.DescribeImagesInput{RepositoryName: &repo})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing images of ECR repository %v, err: %v", repo, err))
+			return nil, err
+		}
+		for _, i := range output.ImageDetails {
+			images = append(images, toEcrImageResp(i))
+		}
+	}
+	return images, nil
+}
+
+func toEcrImageResp(i types.ImageDetail) EcrImageResp {
+	scanStatus, findings := "-", "-"
+	if i.ImageScanStatus != nil {
+		scanStatus = string(i.ImageScanStatus.Status)
+	}
+	if i.ImageScanFindingsSummary != nil {
+		findings = severityCounts(i.ImageScanFindingsSummary.FindingSeverityCounts)
+	}
+	return EcrImageResp{
+		Tags:       strings.Join(i.ImageTags, ","),
+		Digest:     aws.ToString(i.ImageDigest),
+		PushedAt:   ecsLocalTime(i.ImagePushedAt),
+		Size:       humanize.Bytes(uint64(aws.ToInt64(i.ImageSizeInBytes))),
+		ScanStatus: scanStatus,
+		Findings:   findings,
+	}
+}
+
+// severityCounts summarizes finding counts i.e CRITICAL=1,HIGH=3.
+func severityCounts(counts map[string]int32) string {
+	var ss []string
+	for _, s := range ecrSeverities {
+		if n := counts[s]; n > 0 {
+			ss = append(ss, fmt.Sprintf("%s=%d", s, n))
+		}
+	}
+	if len(ss) == 0 {
+		return "none"
+	}
+	return strings.Join(ss, ",")
+}
+
+func severityRank(severity string) int {
+	for i, s := range ecrSeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return len(ecrSeverities)
+}
+
+// GetEcrImageFindings returns the vulnerability findings of an image, most severe first.
+func GetEcrImageFindings(cfg aws.Config, repo, digest string) (*EcrImageFindingsResp, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	resp := &EcrImageFindingsResp{Digest: digest}
+	paginator := ecr.NewDescribeImageScanFindingsPaginator(ecrClient, &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: &repo,
+		ImageId:        &types.ImageIdentifier{ImageDigest: &digest},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			var nf *types.ScanNotFoundException
+			if errors.As(err, &nf) {
+				resp.ScanStatus = "NOT_SCANNED"
+				return resp, nil
+			}
+			log.Info().Msg(fmt.Sprintf("Error describing scan findings of %v@%v, err: %v", repo, digest, err))
+			return nil, err
+		}
+		if s := output.ImageScanStatus; s != nil {
+			resp.ScanStatus, resp.StatusDescription = string(s.Status), aws.ToString(s.Description)
+		}
+		sf := output.ImageScanFindings
+		if sf == nil {
+			continue
+		}
+		resp.SeverityCounts = sf.FindingSeverityCounts
+		for _, f := range sf.Findings {
+			resp.Findings = append(resp.Findings, EcrFindingResp{
+				Name:        aws.ToString(f.Name),
+				Severity:    string(f.Severity),
+				Uri:         aws.ToString(f.Uri),
+				Description: aws.ToString(f.Description),
+			})
+		}
+		for _, f := range sf.EnhancedFindings {
+			finding := EcrFindingResp{
+				Name:        aws.ToString(f.Title),
+				Severity:    aws.ToString(f.Severity),
+				Description: aws.ToString(f.Description),
+			}
+			if d := f.PackageVulnerabilityDetails; d != nil {
+				finding.Uri = aws.ToString(d.SourceUrl)
+				for _, p := range d.VulnerablePackages {
+					finding.Packages = append(finding.Packages, aws.ToString(p.Name)+"@"+aws.ToString(p.Version))
+				}
+			}
+			resp.Findings = append(resp.Findings, finding)
+		}
+	}
+	sort.SliceStable(resp.Findings, func(i, j int) bool {
+		return severityRank(resp.Findings[i].Severity) < severityRank(resp.Findings[j].Severity)
+	})
+	return resp, nil
+}
+
+// DeleteUntaggedEcrImages deletes all untagged images of a repository and
+// returns the number deleted.
+func DeleteUntaggedEcrImages(cfg aws.Config, repo string) (int, error) {
+	ecrClient := ecr.NewFromConfig(cfg)
+	var ids []types.ImageIdentifier
+	paginator := ecr.NewListImagesPaginator(ecrClient, &ecr.ListImagesInput{
+		RepositoryName: &repo,
+		Filter:         &types.ListImagesFilter{TagStatus: types.TagStatusUntagged},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing untagged images of ECR repository %v, err: %v", repo, err))
+			return 0, err
+		}
+		ids = append(ids, output.ImageIds...)
+	}
+
+	var deleted int
+	for start := 0; start < len(ids); start += ecrMaxBatchDelete {
+		end := start + ecrMaxBatchDelete
+		if end > len(ids) {
+			end = len(ids)
+		}
+		output, err := ecrClient.BatchDeleteImage(context.TODO(), &ecr.BatchDeleteImageInput{
+			RepositoryName: &repo,
+			ImageIds:       ids[start:end],
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error deleting untagged images of ECR repository %v, err: %v", repo, err))
+			return deleted, err
+		}
+		deleted += len(output.ImageIds)
+		if len(output.Failures) > 0 {
+			f := output.Failures[0]
+			return deleted, fmt.Errorf("%d images failed to delete, first failure: %s", len(output.Failures), aws.ToString(f.FailureReason))
+		}
+	}
+	return deleted, nil
+}
+
+// DockerPullCommand returns the docker pull command of an image, by tag when
+// it has one or else by digest.
+func DockerPullCommand(repoUri, tags, digest string) string {
+	if tag, _, _ := strings.Cut(tags, ","); tag != "" {
+		return fmt.Sprintf("docker pull %s:%s", repoUri, tag)
+	}
+	return fmt.Sprintf("docker pull %s@%s", repoUri, digest)
+}
@@ -0,0 +1,28 @@
+package aws
+
+import "testing"
+
+func TestSeverityCounts(t *testing.T) {
+	tests := []struct {
+		counts map[string]int32
+		want   string
+	}{
+		{counts: nil, want: "none"},
+		{counts: map[string]int32{"LOW": 3, "CRITICAL": 1, "MEDIUM": 0}, want: "CRITICAL=1,LOW=3"},
+	}
+	for _, tt := range tests {
+		if got := severityCounts(tt.counts); got != tt.want {
+			t.Errorf("severityCounts(%v) = %v, want %v", tt.counts, got, tt.want)
+		}
+	}
+}
+
+func TestDockerPullCommand(t *testing.T) {
+	uri := "123456789012.dkr.ecr.us-east-1.amazonaws.com/web"
+	if got, want := DockerPullCommand(uri, "v2,latest", "sha256:abc"), "docker pull "+uri+":v2"; got != want {
+		t.Errorf("DockerPullCommand() = %v, want %v", got, want)
+	}
+	if got, want := DockerPullCommand(uri, "", "sha256:abc"), "docker pull "+uri+"@sha256:abc"; got != want {
+		t.Errorf("DockerPullCommand() = %v, want %v", got, want)
+	}
+}
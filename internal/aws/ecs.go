@@ -5,10 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ecsMaxServiceEvents is the number of recent service events shown.
+	ecsMaxServiceEvents = 20
+	// ecsMaxDescribeTasks is the maximum number of tasks described per call.
+	ecsMaxDescribeTasks = 100
 )
 
 // --- ECS Clusters ---
@@ -137,16 +148,261 @@ func GetEcsServiceJSONResponse(cfg aws.Config, clusterName, serviceName string)
 	return string(jsonResponse), nil
 }
 
+func GetEcsService(cfg aws.Config, clusterName, serviceName string) (*types.Service, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	result, err := ecsClient.DescribeServices(context.TODO(), &ecs.DescribeServicesInput{
+		Cluster:  &clusterName,
+		Services: []string{serviceName},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing ECS service %v, err: %v", serviceName, err))
+		return nil, err
+	}
+	if len(result.Services) == 0 {
+		return nil, fmt.Errorf("service %s not found in cluster %s", serviceName, clusterName)
+	}
+	return &result.Services[0], nil
+}
+
+// ScaleEcsService updates the desired task count of a service.
+func ScaleEcsService(cfg aws.Config, clusterName, serviceName string, desiredCount int32) error {
+	ecsClient := ecs.NewFromConfig(cfg)
+	_, err := ecsClient.UpdateService(context.TODO(), &ecs.UpdateServiceInput{
+		Cluster:      &clusterName,
+		Service:      &serviceName,
+		DesiredCount: &desiredCount,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error scaling ECS service %v to %d, err: %v", serviceName, desiredCount, err))
+	}
+	return err
+}
+
+// ForceEcsServiceDeployment starts a new deployment of a service using its
+// current task definition.
+func ForceEcsServiceDeployment(cfg aws.Config, clusterName, serviceName string) error {
+	ecsClient := ecs.NewFromConfig(cfg)
+	_, err := ecsClient.UpdateService(context.TODO(), &ecs.UpdateServiceInput{
+		Cluster:            &clusterName,
+		Service:            &serviceName,
+		ForceNewDeployment: true,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error forcing new deployment of ECS service %v, err: %v", serviceName, err))
+	}
+	return err
+}
+
+// ListEcsDeployments returns the deployments of a service, newest first.
+func ListEcsDeployments(cfg aws.Config, clusterName, serviceName string) ([]EcsDeploymentResp, error) {
+	service, err := GetEcsService(cfg, clusterName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	var cb *types.DeploymentCircuitBreaker
+	if service.DeploymentConfiguration != nil {
+		cb = service.DeploymentConfiguration.DeploymentCircuitBreaker
+	}
+	deployments := make([]EcsDeploymentResp, 0, len(service.Deployments))
+	for _, d := range service.Deployments {
+		deployments = append(deployments, EcsDeploymentResp{
+			Id:                 aws.ToString(d.Id),
+			Status:             aws.ToString(d.Status),
+			RolloutState:       string(d.RolloutState),
+			RolloutStateReason: aws.ToString(d.RolloutStateReason),
+			TaskDefinition:     taskDefinitionName(aws.ToString(d.TaskDefinition)),
+			DesiredCount:       d.DesiredCount,
+			RunningCount:       d.RunningCount,
+			PendingCount:       d.PendingCount,
+			FailedTasks:        d.FailedTasks,
+			CircuitBreaker:     circuitBreakerStatus(cb, d),
+			CreatedAt:          ecsLocalTime(d.CreatedAt),
+			UpdatedAt:          ecsLocalTime(d.UpdatedAt),
+		})
+	}
+	return deployments, nil
+}
+
+// ListEcsServiceEvents returns the most recent events of a service.
+func ListEcsServiceEvents(cfg aws.Config, clusterName, serviceName string) ([]EcsServiceEventResp, error) {
+	service, err := GetEcsService(cfg, clusterName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	events := service.Events
+	if len(events) > ecsMaxServiceEvents {
+		events = events[:ecsMaxServiceEvents]
+	}
+	res := make([]EcsServiceEventResp, len(events))
+	for i, e := range events {
+		res[i] = EcsServiceEventResp{
+			CreatedAt: ecsLocalTime(e.CreatedAt),
+			Message:   aws.ToString(e.Message),
+		}
+	}
+	return res, nil
+}
+
+// circuitBreakerStatus describes the deployment circuit breaker of a service
+// deployment i.e enabled/rollback or triggered when it failed the rollout.
+func circuitBreakerStatus(cb *types.DeploymentCircuitBreaker, d types.Deployment) string {
+	if cb == nil || !cb.Enable {
+		return "disabled"
+	}
+	if d.RolloutState == types.DeploymentRolloutStateFailed &&
+		strings.Contains(strings.ToLower(aws.ToString(d.RolloutStateReason)), "circuit breaker") {
+		return "triggered"
+	}
+	if cb.Rollback {
+		return "enabled/rollback"
+	}
+	return "enabled"
+}
+
+// taskDefinitionName returns the family:revision of a task definition arn.
+func taskDefinitionName(arn string) string {
+	parts := strings.Split(arn, "/")
+	return parts[len(parts)-1]
+}
+
+func ecsLocalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	localZone, _ := config.GetLocalTimeZone()
+	loc, _ := time.LoadLocation(localZone)
+	return t.In(loc).Format("Mon Jan _2 15:04:05 2006")
+}
+
+// --- ECS Task Definitions ---
+
+func ListEcsTaskDefinitionFamilies(cfg aws.Config) ([]EcsTaskDefinitionFamilyResp, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	var families []EcsTaskDefinitionFamilyResp
+	paginator := ecs.NewListTaskDefinitionFamiliesPaginator(ecsClient, &ecs.ListTaskDefinitionFamiliesInput{
+		Status: types.TaskDefinitionFamilyStatusActive,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing ECS task definition families, err: %v", err))
+			return nil, err
+		}
+		for _, f := range output.Families {
+			families = append(families, EcsTaskDefinitionFamilyResp{Family: f})
+		}
+	}
+	return families, nil
+}
+
+// ListEcsTaskDefinitions returns the active revisions of a family, newest first.
+func ListEcsTaskDefinitions(cfg aws.Config, family string) ([]EcsTaskDefinitionResp, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	var revisions []EcsTaskDefinitionResp
+	paginator := ecs.NewListTaskDefinitionsPaginator(ecsClient, &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: &family,
+		Sort:         types.SortOrderDesc,
+		Status:       types.TaskDefinitionStatusActive,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing ECS task definitions of %v, err: %v", family, err))
+			return nil, err
+		}
+		for _, arn := range output.TaskDefinitionArns {
+			name := taskDefinitionName(arn)
+			f, revision, _ := strings.Cut(name, ":")
+			// The family prefix also matches longer family names.
+			if f != family {
+				continue
+			}
+			revisions = append(revisions, EcsTaskDefinitionResp{
+				Name:     name,
+				Family:   f,
+				Revision: revision,
+				Arn:      arn,
+			})
+		}
+	}
+	return revisions, nil
+}
+
+// GetEcsTaskDefinition describes a task definition given its family,
+// family:revision or arn.
+func GetEcsTaskDefinition(cfg aws.Config, taskDefinition string) (*types.TaskDefinition, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	output, err := ecsClient.DescribeTaskDefinition(context.TODO(), &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: &taskDefinition,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing ECS task definition %v, err: %v", taskDefinition, err))
+		return nil, err
+	}
+	return output.TaskDefinition, nil
+}
+
+// DeregisterEcsTaskDefinitions deregisters the given revisions, returning the
+// number deregistered and the first error.
+func DeregisterEcsTaskDefinitions(cfg aws.Config, taskDefinitions []string) (int, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	var count int
+	for _, td := range taskDefinitions {
+		td := td
+		_, err := ecsClient.DeregisterTaskDefinition(context.TODO(), &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: &td,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error deregistering ECS task definition %v, err: %v", td, err))
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// DiffEcsTaskDefinitions returns a unified diff of the JSON of two revisions.
+func DiffEcsTaskDefinitions(cfg aws.Config, from, to string) (string, error) {
+	a, err := GetEcsTaskDefinition(cfg, from)
+	if err != nil {
+		return "", err
+	}
+	b, err := GetEcsTaskDefinition(cfg, to)
+	if err != nil {
+		return "", err
+	}
+	return jsonDiff(from, to, a, b)
+}
+
+func jsonDiff(fromName, toName string, from, to interface{}) (string, error) {
+	a, err := json.MarshalIndent(from, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(to, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a)),
+		B:        difflib.SplitLines(string(b)),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
 // --- ECS Tasks ---
 
-func ListEcsTasks(cfg aws.Config, clusterName, serviceName string) ([]EcsTaskResp, error) {
+func ListEcsTasks(cfg aws.Config, clusterName, serviceName string, stopped bool) ([]EcsTaskResp, error) {
 	ecsClient := ecs.NewFromConfig(cfg)
-	taskDetails, err := DescribeEcsTasksForService(ecsClient, clusterName, serviceName)
+	taskDetails, err := DescribeEcsTasksForService(ecsClient, clusterName, serviceName, stopped)
 	if err != nil {
 		return nil, err
 	}
 	tasks := make([]EcsTaskResp, len(taskDetails.Tasks))
 	for i, task := range taskDetails.Tasks {
+		task := task
 		t := &EcsTaskResp{
 			TaskId: GetTaskIDFromArn(*task.TaskArn),
 			Task:   &task,
@@ -156,28 +412,67 @@ func ListEcsTasks(cfg aws.Config, clusterName, serviceName string) ([]EcsTaskRes
 	return tasks, nil
 }
 
-func DescribeEcsTasksForService(ecsClient *ecs.Client, clusterName, serviceName string) (*ecs.DescribeTasksOutput, error) {
-	listTasksInput := &ecs.ListTasksInput{
-		Cluster:     &clusterName,
-		ServiceName: &serviceName,
+// DescribeEcsTasksForService describes the running tasks of a service, along
+// with the recently stopped ones if asked.
+func DescribeEcsTasksForService(ecsClient *ecs.Client, clusterName, serviceName string, stopped bool) (*ecs.DescribeTasksOutput, error) {
+	statuses := []types.DesiredStatus{types.DesiredStatusRunning}
+	if stopped {
+		statuses = append(statuses, types.DesiredStatusStopped)
+	}
+	var taskArns []string
+	for _, status := range statuses {
+		paginator := ecs.NewListTasksPaginator(ecsClient, &ecs.ListTasksInput{
+			Cluster:       &clusterName,
+			ServiceName:   &serviceName,
+			DesiredStatus: status,
+		})
+		for paginator.HasMorePages() {
+			result, err := paginator.NextPage(context.TODO())
+			if err != nil {
+				return nil, err
+			}
+			taskArns = append(taskArns, result.TaskArns...)
+		}
 	}
 
-	result, err := ecsClient.ListTasks(context.TODO(), listTasksInput)
-	if err != nil {
-		return nil, err
+	taskDetails := &ecs.DescribeTasksOutput{}
+	for start := 0; start < len(taskArns); start += ecsMaxDescribeTasks {
+		end := start + ecsMaxDescribeTasks
+		if end > len(taskArns) {
+			end = len(taskArns)
+		}
+		result, err := ecsClient.DescribeTasks(context.TODO(), &ecs.DescribeTasksInput{
+			Cluster: &clusterName,
+			Tasks:   taskArns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		taskDetails.Tasks = append(taskDetails.Tasks, result.Tasks...)
+		taskDetails.Failures = append(taskDetails.Failures, result.Failures...)
 	}
 
-	describeTasksInput := &ecs.DescribeTasksInput{
-		Cluster: &clusterName,
-		Tasks:   result.TaskArns,
-	}
+	return taskDetails, nil
+}
 
-	taskDetails, err := ecsClient.DescribeTasks(context.TODO(), describeTasksInput)
-	if err != nil {
-		return nil, err
+// StopEcsTasks stops the given tasks, returning the number stopped and the first error.
+func StopEcsTasks(cfg aws.Config, clusterName string, taskIds []string, reason string) (int, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	var count int
+	for _, taskId := range taskIds {
+		taskId := taskId
+		_, err := ecsClient.StopTask(context.TODO(), &ecs.StopTaskInput{
+			Cluster: &clusterName,
+			Task:    &taskId,
+			Reason:  &reason,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error stopping ECS task %v, err: %v", taskId, err))
+			return count, err
+		}
+		count++
 	}
-
-	return taskDetails, nil
+	return count, nil
 }
 
 func GetTaskJSONResponse(cfg aws.Config, clusterName, taskArn string) (string, error) {
@@ -238,6 +533,55 @@ func GetECSContainerJsonResponse(cfg aws.Config, clusterName, taskId, runtimeId
 	return "", fmt.Errorf("container %s not found in task %s", runtimeId, taskId)
 }
 
+// GetEcsContainerLogStream resolves the awslogs log group, stream and region
+// of a task container from its task definition.
+func GetEcsContainerLogStream(cfg aws.Config, clusterName, taskId, containerName string) (*EcsLogStreamResp, error) {
+	ecsClient := ecs.NewFromConfig(cfg)
+	taskDetails, err := ecsClient.DescribeTasks(context.TODO(), &ecs.DescribeTasksInput{
+		Cluster: &clusterName,
+		Tasks:   []string{taskId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(taskDetails.Tasks) == 0 {
+		return nil, fmt.Errorf("task with ID %s not found", taskId)
+	}
+	td, err := GetEcsTaskDefinition(cfg, aws.ToString(taskDetails.Tasks[0].TaskDefinitionArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, cd := range td.ContainerDefinitions {
+		if aws.ToString(cd.Name) == containerName {
+			return awsLogStream(cd.LogConfiguration, containerName, GetTaskIDFromArn(taskId), cfg.Region)
+		}
+	}
+	return nil, fmt.Errorf("container %s not found in task definition %s", containerName, aws.ToString(td.Family))
+}
+
+// awsLogStream returns the log stream of an awslogs container,
+// named <prefix>/<container>/<task-id>.
+func awsLogStream(lc *types.LogConfiguration, containerName, taskId, region string) (*EcsLogStreamResp, error) {
+	if lc == nil || lc.LogDriver != types.LogDriverAwslogs {
+		return nil, fmt.Errorf("container %s does not use the awslogs log driver", containerName)
+	}
+	group, prefix := lc.Options["awslogs-group"], lc.Options["awslogs-stream-prefix"]
+	if group == "" {
+		return nil, fmt.Errorf("container %s has no awslogs-group configured", containerName)
+	}
+	if prefix == "" {
+		return nil, fmt.Errorf("container %s has no awslogs-stream-prefix configured, its log stream can not be resolved", containerName)
+	}
+	if r := lc.Options["awslogs-region"]; r != "" {
+		region = r
+	}
+	return &EcsLogStreamResp{
+		Group:  group,
+		Stream: fmt.Sprintf("%s/%s/%s", prefix, containerName, taskId),
+		Region: region,
+	}, nil
+}
+
 func GetTaskIDFromArn(taskArn string) string {
 	parts := strings.Split(taskArn, "/")
 	return parts[len(parts)-1]
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestCircuitBreakerStatus(t *testing.T) {
+	failed := types.Deployment{
+		RolloutState:       types.DeploymentRolloutStateFailed,
+		RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+	}
+	tests := []struct {
+		name string
+		cb   *types.DeploymentCircuitBreaker
+		d    types.Deployment
+		want string
+	}{
+		{name: "none", want: "disabled"},
+		{name: "disabled", cb: &types.DeploymentCircuitBreaker{Rollback: true}, want: "disabled"},
+		{name: "enabled", cb: &types.DeploymentCircuitBreaker{Enable: true}, want: "enabled"},
+		{name: "rollback", cb: &types.DeploymentCircuitBreaker{Enable: true, Rollback: true}, want: "enabled/rollback"},
+		{name: "triggered", cb: &types.DeploymentCircuitBreaker{Enable: true, Rollback: true}, d: failed, want: "triggered"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := circuitBreakerStatus(tt.cb, tt.d); got != tt.want {
+				t.Errorf("circuitBreakerStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskDefinitionName(t *testing.T) {
+	arn := "arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"
+	if got := taskDefinitionName(arn); got != "web:42" {
+		t.Errorf("taskDefinitionName() = %v, want web:42", got)
+	}
+}
+
+func TestJsonDiff(t *testing.T) {
+	a := map[string]interface{}{"cpu": "256", "family": "web"}
+	b := map[string]interface{}{"cpu": "512", "family": "web"}
+
+	diff, err := jsonDiff("web:1", "web:2", a, b)
+	if err != nil {
+		t.Fatalf("jsonDiff() err = %v", err)
+	}
+	want := "--- web:1\n+++ web:2\n@@ -1,4 +1,4 @@\n {\n-  \"cpu\": \"256\",\n+  \"cpu\": \"512\",\n   \"family\": \"web\"\n }\n"
+	if diff != want {
+		t.Errorf("jsonDiff() = %q, want %q", diff, want)
+	}
+}
+
+func TestAwsLogStream(t *testing.T) {
+	lc := &types.LogConfiguration{
+		LogDriver: types.LogDriverAwslogs,
+		Options:   map[string]string{"awslogs-group": "/ecs/web", "awslogs-stream-prefix": "ecs"},
+	}
+
+	s, err := awsLogStream(lc, "app", "abc123", "us-east-1")
+	if err != nil {
+		t.Fatalf("awsLogStream() err = %v", err)
+	}
+	if s.Group != "/ecs/web" || s.Stream != "ecs/app/abc123" || s.Region != "us-east-1" {
+		t.Errorf("awsLogStream() = %+v", s)
+	}
+
+	lc.Options["awslogs-region"] = "eu-west-1"
+	if s, _ := awsLogStream(lc, "app", "abc123", "us-east-1"); s.Region != "eu-west-1" {
+		t.Errorf("awsLogStream() region = %v, want eu-west-1", s.Region)
+	}
+
+	if _, err := awsLogStream(&types.LogConfiguration{LogDriver: types.LogDriverJsonFile}, "app", "abc123", "us-east-1"); err == nil {
+		t.Error("awsLogStream() expected an error for a non awslogs driver")
+	}
+}
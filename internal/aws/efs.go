@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efsTypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/dustin/go-humanize"
+	"github.com/rs/zerolog/log"
+)
+
+func ListFileSystems(cfg aws.Config) ([]FileSystemResp, error) {
+	client := efs.NewFromConfig(cfg)
+	var fileSystems []FileSystemResp
+	paginator := efs.NewDescribeFileSystemsPaginator(client, &efs.DescribeFileSystemsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting file systems, err: %v", err))
+			return nil, err
+		}
+		for _, fs := range output.FileSystems {
+			lifecycle, err := client.DescribeLifecycleConfiguration(context.TODO(), &efs.DescribeLifecycleConfigurationInput{
+				FileSystemId: fs.FileSystemId,
+			})
+			var policies []efsTypes.LifecyclePolicy
+			if err != nil {
+				// The file system is still listed without its lifecycle policy.
+				log.Info().Msg(fmt.Sprintf("Error getting lifecycle configuration of file system %v, err: %v", aws.ToString(fs.FileSystemId), err))
+			} else {
+				policies = lifecycle.LifecyclePolicies
+			}
+			fileSystems = append(fileSystems, fileSystem(fs, policies))
+		}
+	}
+	return fileSystems, nil
+}
+
+func fileSystem(fs efsTypes.FileSystemDescription, policies []efsTypes.LifecyclePolicy) FileSystemResp {
+	var size int64
+	if fs.SizeInBytes != nil {
+		size = fs.SizeInBytes.Value
+	}
+	throughput := string(fs.ThroughputMode)
+	if fs.ThroughputMode == efsTypes.ThroughputModeProvisioned {
+		throughput += fmt.Sprintf(" (%g MiB/s)", aws.ToFloat64(fs.ProvisionedThroughputInMibps))
+	}
+	return FileSystemResp{
+		Id:           aws.ToString(fs.FileSystemId),
+		Name:         aws.ToString(fs.Name),
+		Size:         humanize.Bytes(uint64(size)),
+		Throughput:   throughput,
+		Performance:  string(fs.PerformanceMode),
+		Encrypted:    strconv.FormatBool(aws.ToBool(fs.Encrypted)),
+		Lifecycle:    lifecyclePolicy(policies),
+		MountTargets: strconv.Itoa(int(fs.NumberOfMountTargets)),
+		State:        string(fs.LifeCycleState),
+		Raw:          fs,
+	}
+}
+
+// lifecyclePolicy summarizes the storage class transitions of a file system,
+// e.g. "IA after 30 days, Standard after 1 access".
+func lifecyclePolicy(policies []efsTypes.LifecyclePolicy) string {
+	var transitions []string
+	for _, p := range policies {
+		if p.TransitionToIA != "" {
+			transitions = append(transitions, "IA "+transitionRule(string(p.TransitionToIA)))
+		}
+		if p.TransitionToPrimaryStorageClass != "" {
+			transitions = append(transitions, "Standard "+transitionRule(string(p.TransitionToPrimaryStorageClass)))
+		}
+	}
+	return strings.Join(transitions, ", ")
+}
+
+func transitionRule(rule string) string {
+	return strings.ToLower(strings.ReplaceAll(rule, "_", " "))
+}
+
+func ListMountTargets(cfg aws.Config, fileSystemId string) ([]MountTargetResp, error) {
+	client := efs.NewFromConfig(cfg)
+	var targets []MountTargetResp
+	input := &efs.DescribeMountTargetsInput{FileSystemId: &fileSystemId}
+	for {
+		output, err := client.DescribeMountTargets(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting mount targets of file system %v, err: %v", fileSystemId, err))
+			return nil, err
+		}
+		for _, t := range output.MountTargets {
+			sgs, err := client.DescribeMountTargetSecurityGroups(context.TODO(), &efs.DescribeMountTargetSecurityGroupsInput{
+				MountTargetId: t.MountTargetId,
+			})
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error getting security groups of mount target %v, err: %v", aws.ToString(t.MountTargetId), err))
+				return nil, err
+			}
+			targets = append(targets, mountTarget(t, sgs.SecurityGroups))
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		input.Marker = output.NextMarker
+	}
+	return targets, nil
+}
+
+func mountTarget(t efsTypes.MountTargetDescription, securityGroups []string) MountTargetResp {
+	return MountTargetResp{
+		Id:             aws.ToString(t.MountTargetId),
+		Subnet:         aws.ToString(t.SubnetId),
+		Zone:           aws.ToString(t.AvailabilityZoneName),
+		IpAddress:      aws.ToString(t.IpAddress),
+		State:          string(t.LifeCycleState),
+		VpcId:          aws.ToString(t.VpcId),
+		SecurityGroups: strings.Join(securityGroups, ","),
+		Raw: struct {
+			MountTarget    efsTypes.MountTargetDescription
+			SecurityGroups []string
+		}{t, securityGroups},
+	}
+}
+
+func ListAccessPoints(cfg aws.Config, fileSystemId string) ([]AccessPointResp, error) {
+	var accessPoints []AccessPointResp
+	paginator := efs.NewDescribeAccessPointsPaginator(efs.NewFromConfig(cfg), &efs.DescribeAccessPointsInput{
+		FileSystemId: &fileSystemId,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting access points of file system %v, err: %v", fileSystemId, err))
+			return nil, err
+		}
+		for _, ap := range output.AccessPoints {
+			accessPoints = append(accessPoints, accessPoint(ap))
+		}
+	}
+	return accessPoints, nil
+}
+
+func accessPoint(ap efsTypes.AccessPointDescription) AccessPointResp {
+	resp := AccessPointResp{
+		Id:    aws.ToString(ap.AccessPointId),
+		Name:  aws.ToString(ap.Name),
+		Path:  "/",
+		State: string(ap.LifeCycleState),
+		Raw:   ap,
+	}
+	if ap.RootDirectory != nil && ap.RootDirectory.Path != nil {
+		resp.Path = *ap.RootDirectory.Path
+	}
+	if u := ap.PosixUser; u != nil {
+		resp.PosixUser = fmt.Sprintf("%d:%d", aws.ToInt64(u.Uid), aws.ToInt64(u.Gid))
+	}
+	return resp
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	efsTypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+func TestFileSystem(t *testing.T) {
+	fs := efsTypes.FileSystemDescription{
+		FileSystemId:                 aws.String("fs-0123456789abcdef0"),
+		Name:                         aws.String("shared"),
+		SizeInBytes:                  &efsTypes.FileSystemSize{Value: 6144},
+		ThroughputMode:               efsTypes.ThroughputModeProvisioned,
+		ProvisionedThroughputInMibps: aws.Float64(128),
+		PerformanceMode:              efsTypes.PerformanceModeGeneralPurpose,
+		Encrypted:                    aws.Bool(true),
+		NumberOfMountTargets:         2,
+		LifeCycleState:               efsTypes.LifeCycleStateAvailable,
+	}
+	policies := []efsTypes.LifecyclePolicy{
+		{TransitionToIA: efsTypes.TransitionToIARulesAfter30Days},
+		{TransitionToPrimaryStorageClass: efsTypes.TransitionToPrimaryStorageClassRulesAfter1Access},
+	}
+	want := FileSystemResp{
+		Id:           "fs-0123456789abcdef0",
+		Name:         "shared",
+		Size:         "6.1 kB",
+		Throughput:   "provisioned (128 MiB/s)",
+		Performance:  "generalPurpose",
+		Encrypted:    "true",
+		Lifecycle:    "IA after 30 days, Standard after 1 access",
+		MountTargets: "2",
+		State:        "available",
+		Raw:          fs,
+	}
+	if got := fileSystem(fs, policies); !reflect.DeepEqual(got, want) {
+		t.Errorf("fileSystem() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAccessPoint(t *testing.T) {
+	ap := efsTypes.AccessPointDescription{
+		AccessPointId:  aws.String("fsap-0123456789abcdef0"),
+		Name:           aws.String("app"),
+		LifeCycleState: efsTypes.LifeCycleStateAvailable,
+		PosixUser:      &efsTypes.PosixUser{Uid: aws.Int64(1000), Gid: aws.Int64(1000)},
+	}
+	want := AccessPointResp{Id: "fsap-0123456789abcdef0", Name: "app", Path: "/", PosixUser: "1000:1000", State: "available", Raw: ap}
+	if got := accessPoint(ap); !reflect.DeepEqual(got, want) {
+		t.Errorf("accessPoint() = %+v, want %+v", got, want)
+	}
+}
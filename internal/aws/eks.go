@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	EksNodeGroupType      = "NodeGroup"
+	EksFargateProfileType = "Fargate"
+)
+
+func ListEksClusters(cfg aws.Config) ([]EksClusterResp, error) {
+	eksClient := eks.NewFromConfig(cfg)
+	var clusters []EksClusterResp
+	paginator := eks.NewListClustersPaginator(eksClient, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing EKS clusters, err: %v", err))
+			return nil, err
+		}
+		for _, name := range output.Clusters {
+			c, err := GetEksCluster(cfg, name)
+			if err != nil {
+				return nil, err
+			}
+			clusters = append(clusters, toEksClusterResp(c))
+		}
+	}
+	return clusters, nil
+}
+
+func GetEksCluster(cfg aws.Config, clusterName string) (*types.Cluster, error) {
+	eksClient := eks.NewFromConfig(cfg)
+	output, err := eksClient.DescribeCluster(context.TODO(), &eks.DescribeClusterInput{Name: &clusterName})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing EKS cluster %v, err: %v", clusterName, err))
+		return nil, err
+	}
+	return output.Cluster, nil
+}
+
+// GetEksClusterResp returns the summary of a cluster.
+func GetEksClusterResp(cfg aws.Config, clusterName string) (*EksClusterResp, error) {
+	c, err := GetEksCluster(cfg, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	resp := toEksClusterResp(c)
+	return &resp, nil
+}
+
+func toEksClusterResp(c *types.Cluster) EksClusterResp {
+	var ca string
+	if c.CertificateAuthority != nil {
+		ca = aws.ToString(c.CertificateAuthority.Data)
+	}
+	return EksClusterResp{
+		Name:                 aws.ToString(c.Name),
+		Version:              aws.ToString(c.Version),
+		Status:               string(c.Status),
+		PlatformVersion:      aws.ToString(c.PlatformVersion),
+		Endpoint:             aws.ToString(c.Endpoint),
+		Arn:                  aws.ToString(c.Arn),
+		CertificateAuthority: ca,
+		CreatedAt:            ecsLocalTime(c.CreatedAt),
+	}
+}
+
+// ListEksNodeGroups returns the managed node groups and fargate profiles of a cluster.
+func ListEksNodeGroups(cfg aws.Config, clusterName string) ([]EksNodeGroupResp, error) {
+	eksClient := eks.NewFromConfig(cfg)
+	var groups []EksNodeGroupResp
+	ngPaginator := eks.NewListNodegroupsPaginator(eksClient, &eks.ListNodegroupsInput{ClusterName: &clusterName})
+	for ngPaginator.HasMorePages() {
+		output, err := ngPaginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing node groups of EKS cluster %v, err: %v", clusterName, err))
+			return nil, err
+		}
+		for _, name := range output.Nodegroups {
+			name := name
+			ng, err := eksClient.DescribeNodegroup(context.TODO(), &eks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &name,
+			})
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error describing node group %v, err: %v", name, err))
+				return nil, err
+			}
+			groups = append(groups, toNodeGroupResp(ng.Nodegroup))
+		}
+	}
+
+	fpPaginator := eks.NewListFargateProfilesPaginator(eksClient, &eks.ListFargateProfilesInput{ClusterName: &clusterName})
+	for fpPaginator.HasMorePages() {
+		output, err := fpPaginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing fargate profiles of EKS cluster %v, err: %v", clusterName, err))
+			return nil, err
+		}
+		for _, name := range output.FargateProfileNames {
+			name := name
+			fp, err := eksClient.DescribeFargateProfile(context.TODO(), &eks.DescribeFargateProfileInput{
+				ClusterName:        &clusterName,
+				FargateProfileName: &name,
+			})
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error describing fargate profile %v, err: %v", name, err))
+				return nil, err
+			}
+			groups = append(groups, toFargateProfileResp(fp.FargateProfile))
+		}
+	}
+	return groups, nil
+}
+
+func toNodeGroupResp(ng *types.Nodegroup) EksNodeGroupResp {
+	var scaling string
+	if sc := ng.ScalingConfig; sc != nil {
+		scaling = fmt.Sprintf("%d/%d/%d", aws.ToInt32(sc.DesiredSize), aws.ToInt32(sc.MinSize), aws.ToInt32(sc.MaxSize))
+	}
+	return EksNodeGroupResp{
+		Name:          aws.ToString(ng.NodegroupName),
+		Type:          EksNodeGroupType,
+		Status:        string(ng.Status),
+		Scaling:       scaling,
+		InstanceTypes: strings.Join(ng.InstanceTypes, ","),
+		AmiType:       string(ng.AmiType),
+		CapacityType:  string(ng.CapacityType),
+		Version:       aws.ToString(ng.Version),
+		CreatedAt:     ecsLocalTime(ng.CreatedAt),
+	}
+}
+
+func toFargateProfileResp(fp *types.FargateProfile) EksNodeGroupResp {
+	return EksNodeGroupResp{
+		Name:      aws.ToString(fp.FargateProfileName),
+		Type:      EksFargateProfileType,
+		Status:    string(fp.Status),
+		Selectors: fargateSelectors(fp.Selectors),
+		CreatedAt: ecsLocalTime(fp.CreatedAt),
+	}
+}
+
+// fargateSelectors summarizes profile selectors i.e default,kube-system{app=web}.
+func fargateSelectors(selectors []types.FargateProfileSelector) string {
+	ss := make([]string, 0, len(selectors))
+	for _, s := range selectors {
+		sel := aws.ToString(s.Namespace)
+		if len(s.Labels) > 0 {
+			labels := make([]string, 0, len(s.Labels))
+			for k, v := range s.Labels {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			sel += "{" + strings.Join(labels, ",") + "}"
+		}
+		ss = append(ss, sel)
+	}
+	return strings.Join(ss, ",")
+}
+
+// GetEksNodeGroup describes a node group or else a fargate profile of a cluster.
+func GetEksNodeGroup(cfg aws.Config, clusterName, name string) (interface{}, error) {
+	eksClient := eks.NewFromConfig(cfg)
+	ng, err := eksClient.DescribeNodegroup(context.TODO(), &eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &name,
+	})
+	if err == nil {
+		return ng.Nodegroup, nil
+	}
+	var nf *types.ResourceNotFoundException
+	if !errors.As(err, &nf) {
+		log.Info().Msg(fmt.Sprintf("Error describing node group %v, err: %v", name, err))
+		return nil, err
+	}
+	fp, err := eksClient.DescribeFargateProfile(context.TODO(), &eks.DescribeFargateProfileInput{
+		ClusterName:        &clusterName,
+		FargateProfileName: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing fargate profile %v, err: %v", name, err))
+		return nil, err
+	}
+	return fp.FargateProfile, nil
+}
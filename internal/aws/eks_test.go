@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+func TestFargateSelectors(t *testing.T) {
+	ns1, ns2 := "default", "kube-system"
+	got := fargateSelectors([]types.FargateProfileSelector{
+		{Namespace: &ns1},
+		{Namespace: &ns2, Labels: map[string]string{"k8s-app": "kube-dns", "app": "dns"}},
+	})
+	if want := "default,kube-system{app=dns,k8s-app=kube-dns}"; got != want {
+		t.Errorf("fargateSelectors() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,363 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	ecTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	mdbTypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/rs/zerolog/log"
+)
+
+// Cache kinds, telling where a cache id comes from.
+const (
+	CacheKindReplicationGroup = "replication-group"
+	CacheKindCluster          = "cache-cluster"
+	CacheKindMemoryDB         = "memorydb"
+)
+
+// cacheResource holds an ElastiCache replication group with its member
+// clusters, a standalone ElastiCache cluster or a MemoryDB cluster.
+type cacheResource struct {
+	group    *ecTypes.ReplicationGroup
+	clusters []ecTypes.CacheCluster
+	memorydb *mdbTypes.Cluster
+}
+
+// ListCaches returns the ElastiCache replication groups and standalone
+// clusters along with the MemoryDB clusters of the region.
+func ListCaches(cfg aws.Config) ([]CacheResp, error) {
+	client := elasticache.NewFromConfig(cfg)
+	clusters, err := describeCacheClusters(client, nil)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := describeReplicationGroups(client, nil)
+	if err != nil {
+		return nil, err
+	}
+	// ElastiCache is still listed when MemoryDB can't be.
+	mdbClusters, err := describeMemoryDBClusters(cfg, nil)
+	if err != nil && !memoryDBUnavailable(err) {
+		log.Error().Err(err).Msg("failed to list memorydb clusters")
+	}
+
+	var caches []CacheResp
+	for _, r := range cacheResources(groups, clusters, mdbClusters) {
+		caches = append(caches, r.summary())
+	}
+	return caches, nil
+}
+
+// memoryDBUnavailable checks whether an error tells MemoryDB isn't available
+// in the region, its endpoint then not resolving.
+func memoryDBUnavailable(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+func cacheResources(groups []ecTypes.ReplicationGroup, clusters []ecTypes.CacheCluster, mdbClusters []mdbTypes.Cluster) []cacheResource {
+	members := make(map[string][]ecTypes.CacheCluster)
+	var resources []cacheResource
+	for _, c := range clusters {
+		if c.ReplicationGroupId != nil {
+			members[*c.ReplicationGroupId] = append(members[*c.ReplicationGroupId], c)
+			continue
+		}
+		resources = append(resources, cacheResource{clusters: []ecTypes.CacheCluster{c}})
+	}
+	for i := range groups {
+		g := &groups[i]
+		resources = append(resources, cacheResource{group: g, clusters: members[aws.ToString(g.ReplicationGroupId)]})
+	}
+	for i := range mdbClusters {
+		resources = append(resources, cacheResource{memorydb: &mdbClusters[i]})
+	}
+	return resources
+}
+
+func describeCacheClusters(client *elasticache.Client, id *string) ([]ecTypes.CacheCluster, error) {
+	var clusters []ecTypes.CacheCluster
+	paginator := elasticache.NewDescribeCacheClustersPaginator(client, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId:    id,
+		ShowCacheNodeInfo: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing cache clusters, err: %v", err))
+			return nil, err
+		}
+		clusters = append(clusters, output.CacheClusters...)
+	}
+	return clusters, nil
+}
+
+func describeReplicationGroups(client *elasticache.Client, id *string) ([]ecTypes.ReplicationGroup, error) {
+	var groups []ecTypes.ReplicationGroup
+	paginator := elasticache.NewDescribeReplicationGroupsPaginator(client, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: id,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing replication groups, err: %v", err))
+			return nil, err
+		}
+		groups = append(groups, output.ReplicationGroups...)
+	}
+	return groups, nil
+}
+
+func describeMemoryDBClusters(cfg aws.Config, name *string) ([]mdbTypes.Cluster, error) {
+	var clusters []mdbTypes.Cluster
+	paginator := memorydb.NewDescribeClustersPaginator(memorydb.NewFromConfig(cfg), &memorydb.DescribeClustersInput{
+		ClusterName:      name,
+		ShowShardDetails: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing memorydb clusters, err: %v", err))
+			return nil, err
+		}
+		clusters = append(clusters, output.Clusters...)
+	}
+	return clusters, nil
+}
+
+// getCacheResource fetches a cache given its kind and id.
+func getCacheResource(cfg aws.Config, kind, id string) (*cacheResource, error) {
+	switch kind {
+	case CacheKindMemoryDB:
+		clusters, err := describeMemoryDBClusters(cfg, &id)
+		if err != nil {
+			return nil, err
+		}
+		if len(clusters) == 0 {
+			return nil, fmt.Errorf("memorydb cluster %s not found", id)
+		}
+		return &cacheResource{memorydb: &clusters[0]}, nil
+	case CacheKindReplicationGroup:
+		client := elasticache.NewFromConfig(cfg)
+		groups, err := describeReplicationGroups(client, &id)
+		if err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 {
+			return nil, fmt.Errorf("replication group %s not found", id)
+		}
+		r := cacheResource{group: &groups[0]}
+		for _, member := range groups[0].MemberClusters {
+			member := member
+			clusters, err := describeCacheClusters(client, &member)
+			if err != nil {
+				return nil, err
+			}
+			r.clusters = append(r.clusters, clusters...)
+		}
+		return &r, nil
+	default:
+		clusters, err := describeCacheClusters(elasticache.NewFromConfig(cfg), &id)
+		if err != nil {
+			return nil, err
+		}
+		return &cacheResource{clusters: clusters}, nil
+	}
+}
+
+// GetCache describes a cache along with its parameter group and security groups.
+func GetCache(cfg aws.Config, kind, id string) (*CacheDetailsResp, error) {
+	r, err := getCacheResource(cfg, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	details := r.details()
+	return &details, nil
+}
+
+// ListCacheNodes returns the nodes of a cache.
+func ListCacheNodes(cfg aws.Config, kind, id string) ([]CacheNodeResp, error) {
+	r, err := getCacheResource(cfg, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.nodes(), nil
+}
+
+func (r cacheResource) summary() CacheResp {
+	if m := r.memorydb; m != nil {
+		var nodes int
+		for _, s := range m.Shards {
+			nodes += len(s.Nodes)
+		}
+		return CacheResp{
+			Id:       aws.ToString(m.Name),
+			Kind:     CacheKindMemoryDB,
+			Engine:   "redis " + aws.ToString(m.EngineVersion),
+			NodeType: aws.ToString(m.NodeType),
+			Nodes:    strconv.Itoa(nodes),
+			Shards:   strconv.Itoa(int(aws.ToInt32(m.NumberOfShards))),
+			Status:   aws.ToString(m.Status),
+			Endpoint: mdbEndpoint(m.ClusterEndpoint),
+		}
+	}
+	var engine string
+	if len(r.clusters) > 0 {
+		engine = aws.ToString(r.clusters[0].Engine) + " " + aws.ToString(r.clusters[0].EngineVersion)
+	}
+	if g := r.group; g != nil {
+		endpoint := ecEndpoint(g.ConfigurationEndpoint)
+		if endpoint == "" && len(g.NodeGroups) > 0 {
+			endpoint = ecEndpoint(g.NodeGroups[0].PrimaryEndpoint)
+		}
+		return CacheResp{
+			Id:       aws.ToString(g.ReplicationGroupId),
+			Kind:     CacheKindReplicationGroup,
+			Engine:   engine,
+			NodeType: aws.ToString(g.CacheNodeType),
+			Nodes:    strconv.Itoa(len(g.MemberClusters)),
+			Shards:   strconv.Itoa(len(g.NodeGroups)),
+			Status:   aws.ToString(g.Status),
+			Endpoint: endpoint,
+		}
+	}
+	if len(r.clusters) == 0 {
+		return CacheResp{}
+	}
+	c := r.clusters[0]
+	endpoint := ecEndpoint(c.ConfigurationEndpoint)
+	if endpoint == "" && len(c.CacheNodes) > 0 {
+		endpoint = ecEndpoint(c.CacheNodes[0].Endpoint)
+	}
+	return CacheResp{
+		Id:       aws.ToString(c.CacheClusterId),
+		Kind:     CacheKindCluster,
+		Engine:   engine,
+		NodeType: aws.ToString(c.CacheNodeType),
+		Nodes:    strconv.Itoa(int(aws.ToInt32(c.NumCacheNodes))),
+		Shards:   clusterShards(c),
+		Status:   aws.ToString(c.CacheClusterStatus),
+		Endpoint: endpoint,
+	}
+}
+
+func (r cacheResource) details() CacheDetailsResp {
+	if m := r.memorydb; m != nil {
+		var sgs []string
+		for _, sg := range m.SecurityGroups {
+			sgs = append(sgs, aws.ToString(sg.SecurityGroupId))
+		}
+		return CacheDetailsResp{ParameterGroup: aws.ToString(m.ParameterGroupName), SecurityGroups: sgs, Cache: m}
+	}
+	var details CacheDetailsResp
+	seen := make(map[string]bool)
+	for _, c := range r.clusters {
+		if details.ParameterGroup == "" && c.CacheParameterGroup != nil {
+			details.ParameterGroup = aws.ToString(c.CacheParameterGroup.CacheParameterGroupName)
+		}
+		for _, sg := range c.SecurityGroups {
+			if id := aws.ToString(sg.SecurityGroupId); !seen[id] {
+				seen[id] = true
+				details.SecurityGroups = append(details.SecurityGroups, id)
+			}
+		}
+	}
+	sort.Strings(details.SecurityGroups)
+	details.Cache = r.clusters
+	if r.group != nil {
+		details.Cache = r.group
+	} else if len(r.clusters) == 1 {
+		details.Cache = r.clusters[0]
+	}
+	return details
+}
+
+func (r cacheResource) nodes() []CacheNodeResp {
+	var nodes []CacheNodeResp
+	if m := r.memorydb; m != nil {
+		for _, s := range m.Shards {
+			for _, n := range s.Nodes {
+				nodes = append(nodes, CacheNodeResp{
+					Id:       aws.ToString(n.Name),
+					Shard:    aws.ToString(s.Name),
+					Zone:     aws.ToString(n.AvailabilityZone),
+					Status:   aws.ToString(n.Status),
+					Endpoint: mdbEndpoint(n.Endpoint),
+					Created:  ecsLocalTime(n.CreateTime),
+					Raw:      n,
+				})
+			}
+		}
+		return nodes
+	}
+	if g := r.group; g != nil {
+		roles, shards := make(map[string]string), make(map[string]string)
+		for _, ng := range g.NodeGroups {
+			for _, m := range ng.NodeGroupMembers {
+				id := aws.ToString(m.CacheClusterId)
+				roles[id], shards[id] = aws.ToString(m.CurrentRole), aws.ToString(ng.NodeGroupId)
+			}
+		}
+		for _, c := range r.clusters {
+			id := aws.ToString(c.CacheClusterId)
+			node := CacheNodeResp{
+				Id:      id,
+				Role:    roles[id],
+				Shard:   shards[id],
+				Zone:    aws.ToString(c.PreferredAvailabilityZone),
+				Status:  aws.ToString(c.CacheClusterStatus),
+				Created: ecsLocalTime(c.CacheClusterCreateTime),
+				Raw:     c,
+			}
+			if len(c.CacheNodes) > 0 {
+				node.Endpoint = ecEndpoint(c.CacheNodes[0].Endpoint)
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+	for _, c := range r.clusters {
+		for _, n := range c.CacheNodes {
+			nodes = append(nodes, CacheNodeResp{
+				Id:       aws.ToString(n.CacheNodeId),
+				Zone:     aws.ToString(n.CustomerAvailabilityZone),
+				Status:   aws.ToString(n.CacheNodeStatus),
+				Endpoint: ecEndpoint(n.Endpoint),
+				Created:  ecsLocalTime(n.CacheNodeCreateTime),
+				Raw:      n,
+			})
+		}
+	}
+	return nodes
+}
+
+// clusterShards returns the shard count of a standalone cluster, none for
+// memcached which spreads keys client side.
+func clusterShards(c ecTypes.CacheCluster) string {
+	if aws.ToString(c.Engine) == "memcached" {
+		return ""
+	}
+	return "1"
+}
+
+func ecEndpoint(e *ecTypes.Endpoint) string {
+	if e == nil || e.Address == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", *e.Address, e.Port)
+}
+
+func mdbEndpoint(e *mdbTypes.Endpoint) string {
+	if e == nil || e.Address == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", *e.Address, e.Port)
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	mdbTypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+)
+
+func testCaches() ([]ecTypes.ReplicationGroup, []ecTypes.CacheCluster, []mdbTypes.Cluster) {
+	groups := []ecTypes.ReplicationGroup{{
+		ReplicationGroupId: aws.String("sessions"),
+		CacheNodeType:      aws.String("cache.t3.micro"),
+		MemberClusters:     []string{"sessions-001", "sessions-002"},
+		Status:             aws.String("available"),
+		NodeGroups: []ecTypes.NodeGroup{{
+			NodeGroupId:     aws.String("0001"),
+			PrimaryEndpoint: &ecTypes.Endpoint{Address: aws.String("sessions.cache.amazonaws.com"), Port: 6379},
+			NodeGroupMembers: []ecTypes.NodeGroupMember{
+				{CacheClusterId: aws.String("sessions-001"), CurrentRole: aws.String("primary")},
+				{CacheClusterId: aws.String("sessions-002"), CurrentRole: aws.String("replica")},
+			},
+		}},
+	}}
+	member := func(id, sg string) ecTypes.CacheCluster {
+		return ecTypes.CacheCluster{
+			CacheClusterId:      aws.String(id),
+			ReplicationGroupId:  aws.String("sessions"),
+			Engine:              aws.String("redis"),
+			EngineVersion:       aws.String("7.0.7"),
+			CacheParameterGroup: &ecTypes.CacheParameterGroupStatus{CacheParameterGroupName: aws.String("default.redis7")},
+			SecurityGroups:      []ecTypes.SecurityGroupMembership{{SecurityGroupId: aws.String(sg)}},
+		}
+	}
+	clusters := []ecTypes.CacheCluster{
+		member("sessions-001", "sg-2"),
+		member("sessions-002", "sg-1"),
+		{
+			CacheClusterId:        aws.String("pages"),
+			Engine:                aws.String("memcached"),
+			EngineVersion:         aws.String("1.6.17"),
+			CacheNodeType:         aws.String("cache.t3.small"),
+			NumCacheNodes:         aws.Int32(2),
+			CacheClusterStatus:    aws.String("available"),
+			ConfigurationEndpoint: &ecTypes.Endpoint{Address: aws.String("pages.cfg.cache.amazonaws.com"), Port: 11211},
+		},
+	}
+	mdb := []mdbTypes.Cluster{{
+		Name:            aws.String("orders"),
+		EngineVersion:   aws.String("6.2"),
+		NodeType:        aws.String("db.t4g.small"),
+		NumberOfShards:  aws.Int32(1),
+		Status:          aws.String("available"),
+		ClusterEndpoint: &mdbTypes.Endpoint{Address: aws.String("clustercfg.orders.memorydb.amazonaws.com"), Port: 6379},
+		Shards:          []mdbTypes.Shard{{Name: aws.String("0001"), Nodes: []mdbTypes.Node{{Name: aws.String("orders-0001-001")}, {Name: aws.String("orders-0001-002")}}}},
+	}}
+	return groups, clusters, mdb
+}
+
+func TestCacheSummaries(t *testing.T) {
+	var got []CacheResp
+	for _, r := range cacheResources(testCaches()) {
+		got = append(got, r.summary())
+	}
+
+	want := []CacheResp{
+		{Id: "pages", Kind: CacheKindCluster, Engine: "memcached 1.6.17", NodeType: "cache.t3.small", Nodes: "2", Status: "available", Endpoint: "pages.cfg.cache.amazonaws.com:11211"},
+		{Id: "sessions", Kind: CacheKindReplicationGroup, Engine: "redis 7.0.7", NodeType: "cache.t3.micro", Nodes: "2", Shards: "1", Status: "available", Endpoint: "sessions.cache.amazonaws.com:6379"},
+		{Id: "orders", Kind: CacheKindMemoryDB, Engine: "redis 6.2", NodeType: "db.t4g.small", Nodes: "2", Shards: "1", Status: "available", Endpoint: "clustercfg.orders.memorydb.amazonaws.com:6379"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("summary() = %v, want %v", got, want)
+	}
+}
+
+func TestCacheDetailsAndNodes(t *testing.T) {
+	resources := cacheResources(testCaches())
+	group := resources[1]
+
+	details := group.details()
+	if details.ParameterGroup != "default.redis7" || !reflect.DeepEqual(details.SecurityGroups, []string{"sg-1", "sg-2"}) {
+		t.Errorf("details() = %v, %v", details.ParameterGroup, details.SecurityGroups)
+	}
+
+	nodes := group.nodes()
+	if len(nodes) != 2 || nodes[0].Role != "primary" || nodes[1].Role != "replica" || nodes[1].Shard != "0001" {
+		t.Errorf("nodes() = %v", nodes)
+	}
+	if nodes := resources[2].nodes(); len(nodes) != 2 || nodes[0].Id != "orders-0001-001" || nodes[0].Shard != "0001" {
+		t.Errorf("nodes() = %v", nodes)
+	}
+}
+
+func TestMemoryDBUnavailable(t *testing.T) {
+	uu := map[string]struct {
+		err         error
+		unavailable bool
+	}{
+		"no-host": {
+			err:         fmt.Errorf("operation error MemoryDB: DescribeClusters, %w", &net.DNSError{Err: "no such host", Name: "memory-db.ap-east-1.amazonaws.com", IsNotFound: true}),
+			unavailable: true,
+		},
+		"timeout": {
+			err: fmt.Errorf("operation error MemoryDB: DescribeClusters, %w", &net.DNSError{Err: "i/o timeout", Name: "memory-db.us-east-1.amazonaws.com", IsTimeout: true}),
+		},
+		"access-denied": {
+			err: errors.New("operation error MemoryDB: DescribeClusters, api error AccessDeniedException: not authorized"),
+		},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := memoryDBUnavailable(u.err); got != u.unavailable {
+				t.Errorf("memoryDBUnavailable() = %v, want %v", got, u.unavailable)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2Types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ElbClassicType is the type of classic load balancers.
+const ElbClassicType = "classic"
+
+// ListLoadBalancers returns the application, network, gateway and classic load balancers.
+func ListLoadBalancers(cfg aws.Config) ([]LoadBalancerResp, error) {
+	var lbs []LoadBalancerResp
+	v2Paginator := elbv2.NewDescribeLoadBalancersPaginator(elbv2.NewFromConfig(cfg), &elbv2.DescribeLoadBalancersInput{})
+	for v2Paginator.HasMorePages() {
+		output, err := v2Paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing load balancers, err: %v", err))
+			return nil, err
+		}
+		for _, lb := range output.LoadBalancers {
+			var state string
+			if lb.State != nil {
+				state = string(lb.State.Code)
+			}
+			lbs = append(lbs, LoadBalancerResp{
+				Name:      aws.ToString(lb.LoadBalancerName),
+				Type:      string(lb.Type),
+				Scheme:    string(lb.Scheme),
+				State:     state,
+				DNSName:   aws.ToString(lb.DNSName),
+				VpcId:     aws.ToString(lb.VpcId),
+				CreatedAt: ecsLocalTime(lb.CreatedTime),
+				Arn:       aws.ToString(lb.LoadBalancerArn),
+			})
+		}
+	}
+
+	classicPaginator := elb.NewDescribeLoadBalancersPaginator(elb.NewFromConfig(cfg), &elb.DescribeLoadBalancersInput{})
+	for classicPaginator.HasMorePages() {
+		output, err := classicPaginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing classic load balancers, err: %v", err))
+			return nil, err
+		}
+		for _, lb := range output.LoadBalancerDescriptions {
+			lbs = append(lbs, LoadBalancerResp{
+				Name:      aws.ToString(lb.LoadBalancerName),
+				Type:      ElbClassicType,
+				Scheme:    aws.ToString(lb.Scheme),
+				State:     "-",
+				DNSName:   aws.ToString(lb.DNSName),
+				VpcId:     aws.ToString(lb.VPCId),
+				CreatedAt: ecsLocalTime(lb.CreatedTime),
+			})
+		}
+	}
+	return lbs, nil
+}
+
+// GetLoadBalancer describes a load balancer of the given type by name.
+func GetLoadBalancer(cfg aws.Config, name, lbType string) (interface{}, error) {
+	if lbType == ElbClassicType {
+		output, err := elb.NewFromConfig(cfg).DescribeLoadBalancers(context.TODO(), &elb.DescribeLoadBalancersInput{
+			LoadBalancerNames: []string{name},
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing classic load balancer %v, err: %v", name, err))
+			return nil, err
+		}
+		if len(output.LoadBalancerDescriptions) == 0 {
+			return nil, fmt.Errorf("load balancer %s not found", name)
+		}
+		return output.LoadBalancerDescriptions[0], nil
+	}
+	lb, err := getLoadBalancerV2(elbv2.NewFromConfig(cfg), name)
+	if err != nil {
+		return nil, err
+	}
+	return lb, nil
+}
+
+func getLoadBalancerV2(client *elbv2.Client, name string) (*elbv2Types.LoadBalancer, error) {
+	output, err := client.DescribeLoadBalancers(context.TODO(), &elbv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing load balancer %v, err: %v", name, err))
+		return nil, err
+	}
+	if len(output.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("load balancer %s not found", name)
+	}
+	return &output.LoadBalancers[0], nil
+}
+
+// ListLoadBalancerRules returns the listener rules of a load balancer. Classic
+// load balancers have a single rule per listener forwarding to their instances.
+func ListLoadBalancerRules(cfg aws.Config, name, lbType string) ([]ElbRuleResp, error) {
+	if lbType == ElbClassicType {
+		lb, err := GetLoadBalancer(cfg, name, lbType)
+		if err != nil {
+			return nil, err
+		}
+		var rules []ElbRuleResp
+		for _, ld := range lb.(elbTypes.LoadBalancerDescription).ListenerDescriptions {
+			l := ld.Listener
+			if l == nil {
+				continue
+			}
+			listener := fmt.Sprintf("%s:%d", aws.ToString(l.Protocol), l.LoadBalancerPort)
+			rules = append(rules, ElbRuleResp{
+				Id:       listener,
+				Listener: listener,
+				Priority: "default",
+				Actions:  fmt.Sprintf("forward:%s:%d", aws.ToString(l.InstanceProtocol), l.InstancePort),
+				Raw:      ld,
+			})
+		}
+		return rules, nil
+	}
+
+	client := elbv2.NewFromConfig(cfg)
+	lb, err := getLoadBalancerV2(client, name)
+	if err != nil {
+		return nil, err
+	}
+	var rules []ElbRuleResp
+	listeners := elbv2.NewDescribeListenersPaginator(client, &elbv2.DescribeListenersInput{LoadBalancerArn: lb.LoadBalancerArn})
+	for listeners.HasMorePages() {
+		output, err := listeners.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing listeners of %v, err: %v", name, err))
+			return nil, err
+		}
+		for _, l := range output.Listeners {
+			listener := fmt.Sprintf("%s:%d", l.Protocol, aws.ToInt32(l.Port))
+			if lb.Type != elbv2Types.LoadBalancerTypeEnumApplication {
+				// Only application load balancers have listener rules.
+				actions, tgs := ruleActions(l.DefaultActions)
+				rules = append(rules, ElbRuleResp{Id: listener, Listener: listener, Priority: "default", Actions: actions, TargetGroups: tgs, Raw: l})
+				continue
+			}
+			lr, err := listenerRules(client, l.ListenerArn)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range lr {
+				priority := aws.ToString(r.Priority)
+				actions, tgs := ruleActions(r.Actions)
+				rules = append(rules, ElbRuleResp{
+					Id:           listener + " #" + priority,
+					Listener:     listener,
+					Priority:     priority,
+					Conditions:   ruleConditions(r.Conditions),
+					Actions:      actions,
+					TargetGroups: tgs,
+					Raw:          r,
+				})
+			}
+		}
+	}
+	return rules, nil
+}
+
+func listenerRules(client *elbv2.Client, listenerArn *string) ([]elbv2Types.Rule, error) {
+	var rules []elbv2Types.Rule
+	input := &elbv2.DescribeRulesInput{ListenerArn: listenerArn}
+	for {
+		output, err := client.DescribeRules(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing rules of listener %v, err: %v", aws.ToString(listenerArn), err))
+			return nil, err
+		}
+		rules = append(rules, output.Rules...)
+		if output.NextMarker == nil {
+			break
+		}
+		input.Marker = output.NextMarker
+	}
+	return rules, nil
+}
+
+// ruleConditions summarizes rule conditions i.e path-pattern=/api/*.
+func ruleConditions(conditions []elbv2Types.RuleCondition) string {
+	cc := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		values := c.Values
+		switch {
+		case c.HostHeaderConfig != nil:
+			values = c.HostHeaderConfig.Values
+		case c.PathPatternConfig != nil:
+			values = c.PathPatternConfig.Values
+		case c.HttpHeaderConfig != nil:
+			values = []string{aws.ToString(c.HttpHeaderConfig.HttpHeaderName) + ":" + strings.Join(c.HttpHeaderConfig.Values, "|")}
+		case c.HttpRequestMethodConfig != nil:
+			values = c.HttpRequestMethodConfig.Values
+		case c.SourceIpConfig != nil:
+			values = c.SourceIpConfig.Values
+		case c.QueryStringConfig != nil:
+			values = nil
+			for _, kv := range c.QueryStringConfig.Values {
+				values = append(values, aws.ToString(kv.Key)+":"+aws.ToString(kv.Value))
+			}
+		}
+		cc = append(cc, aws.ToString(c.Field)+"="+strings.Join(values, "|"))
+	}
+	return strings.Join(cc, ",")
+}
+
+// ruleActions summarizes rule actions and returns the names of the target
+// groups they forward to.
+func ruleActions(actions []elbv2Types.Action) (string, string) {
+	sort.SliceStable(actions, func(i, j int) bool {
+		return aws.ToInt32(actions[i].Order) < aws.ToInt32(actions[j].Order)
+	})
+	var aa, tgs []string
+	for _, a := range actions {
+		switch a.Type {
+		case elbv2Types.ActionTypeEnumForward:
+			var names []string
+			if a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) > 0 {
+				for _, tg := range a.ForwardConfig.TargetGroups {
+					names = append(names, targetGroupName(aws.ToString(tg.TargetGroupArn)))
+				}
+			} else if a.TargetGroupArn != nil {
+				names = append(names, targetGroupName(*a.TargetGroupArn))
+			}
+			tgs = append(tgs, names...)
+			aa = append(aa, "forward:"+strings.Join(names, "|"))
+		case elbv2Types.ActionTypeEnumRedirect:
+			r := a.RedirectConfig
+			if r == nil {
+				aa = append(aa, string(a.Type))
+				continue
+			}
+			aa = append(aa, fmt.Sprintf("redirect:%s://%s:%s%s", aws.ToString(r.Protocol), aws.ToString(r.Host), aws.ToString(r.Port), aws.ToString(r.Path)))
+		case elbv2Types.ActionTypeEnumFixedResponse:
+			if a.FixedResponseConfig == nil {
+				aa = append(aa, string(a.Type))
+				continue
+			}
+			aa = append(aa, "fixed-response:"+aws.ToString(a.FixedResponseConfig.StatusCode))
+		default:
+			aa = append(aa, string(a.Type))
+		}
+	}
+	return strings.Join(aa, ","), strings.Join(tgs, ",")
+}
+
+// targetGroupName returns the name of a target group arn,
+// arn:aws:elasticloadbalancing:region:account:targetgroup/name/id.
+func targetGroupName(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 2 {
+		return arn
+	}
+	return parts[1]
+}
+
+// ListTargetHealth returns the health of the targets of the given target
+// groups, or of the instances of a classic load balancer.
+func ListTargetHealth(cfg aws.Config, lbName, lbType string, targetGroups []string) ([]ElbTargetHealthResp, error) {
+	if lbType == ElbClassicType {
+		output, err := elb.NewFromConfig(cfg).DescribeInstanceHealth(context.TODO(), &elb.DescribeInstanceHealthInput{
+			LoadBalancerName: &lbName,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing instance health of %v, err: %v", lbName, err))
+			return nil, err
+		}
+		targets := make([]ElbTargetHealthResp, len(output.InstanceStates))
+		for i, s := range output.InstanceStates {
+			targets[i] = ElbTargetHealthResp{
+				Target:      aws.ToString(s.InstanceId),
+				TargetGroup: lbName,
+				State:       aws.ToString(s.State),
+				Reason:      aws.ToString(s.ReasonCode),
+				Description: aws.ToString(s.Description),
+				Raw:         s,
+			}
+		}
+		return targets, nil
+	}
+
+	if len(targetGroups) == 0 {
+		return nil, nil
+	}
+	client := elbv2.NewFromConfig(cfg)
+	tgs, err := client.DescribeTargetGroups(context.TODO(), &elbv2.DescribeTargetGroupsInput{Names: targetGroups})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing target groups %v, err: %v", targetGroups, err))
+		return nil, err
+	}
+	var targets []ElbTargetHealthResp
+	for _, tg := range tgs.TargetGroups {
+		output, err := client.DescribeTargetHealth(context.TODO(), &elbv2.DescribeTargetHealthInput{TargetGroupArn: tg.TargetGroupArn})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing target health of %v, err: %v", aws.ToString(tg.TargetGroupName), err))
+			return nil, err
+		}
+		for _, th := range output.TargetHealthDescriptions {
+			t := ElbTargetHealthResp{TargetGroup: aws.ToString(tg.TargetGroupName), Raw: th}
+			if th.Target != nil {
+				t.Target = aws.ToString(th.Target.Id)
+				if th.Target.Port != nil {
+					t.Target = fmt.Sprintf("%s:%d", t.Target, *th.Target.Port)
+				}
+			}
+			if h := th.TargetHealth; h != nil {
+				t.State, t.Reason, t.Description = string(h.State), string(h.Reason), aws.ToString(h.Description)
+			}
+			targets = append(targets, t)
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2Types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+func TestRuleConditions(t *testing.T) {
+	conditions := []elbv2Types.RuleCondition{
+		{Field: aws.String("host-header"), HostHeaderConfig: &elbv2Types.HostHeaderConditionConfig{Values: []string{"a.com", "b.com"}}},
+		{Field: aws.String("path-pattern"), Values: []string{"/api/*"}},
+	}
+	if got, want := ruleConditions(conditions), "host-header=a.com|b.com,path-pattern=/api/*"; got != want {
+		t.Errorf("ruleConditions() = %q, want %q", got, want)
+	}
+}
+
+func TestRuleActions(t *testing.T) {
+	actions := []elbv2Types.Action{
+		{Type: elbv2Types.ActionTypeEnumFixedResponse, Order: aws.Int32(2), FixedResponseConfig: &elbv2Types.FixedResponseActionConfig{StatusCode: aws.String("404")}},
+		{Type: elbv2Types.ActionTypeEnumForward, Order: aws.Int32(1), TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:1:targetgroup/api/abc")},
+	}
+	got, tgs := ruleActions(actions)
+	if want := "forward:api,fixed-response:404"; got != want {
+		t.Errorf("ruleActions() = %q, want %q", got, want)
+	}
+	if tgs != "api" {
+		t.Errorf("ruleActions() target groups = %q, want %q", tgs, "api")
+	}
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebTypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListEventBuses returns the event buses of the region with their rule count.
+func ListEventBuses(cfg aws.Config) ([]EventBusResp, error) {
+	client := eventbridge.NewFromConfig(cfg)
+	var buses []EventBusResp
+	input := &eventbridge.ListEventBusesInput{}
+	for {
+		output, err := client.ListEventBuses(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing event buses, err: %v", err))
+			return nil, err
+		}
+		for _, b := range output.EventBuses {
+			rules, err := listRules(client, aws.ToString(b.Name))
+			if err != nil {
+				return nil, err
+			}
+			buses = append(buses, EventBusResp{
+				Name:  aws.ToString(b.Name),
+				Rules: fmt.Sprintf("%d", len(rules)),
+				Arn:   aws.ToString(b.Arn),
+			})
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return buses, nil
+}
+
+func listRules(client *eventbridge.Client, bus string) ([]ebTypes.Rule, error) {
+	var rules []ebTypes.Rule
+	input := &eventbridge.ListRulesInput{EventBusName: &bus}
+	for {
+		output, err := client.ListRules(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing rules of event bus %v, err: %v", bus, err))
+			return nil, err
+		}
+		rules = append(rules, output.Rules...)
+		if output.NextToken == nil {
+			return rules, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func listRuleTargets(client *eventbridge.Client, bus, rule string) ([]ebTypes.Target, error) {
+	var targets []ebTypes.Target
+	input := &eventbridge.ListTargetsByRuleInput{EventBusName: &bus, Rule: &rule}
+	for {
+		output, err := client.ListTargetsByRule(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing targets of rule %v, err: %v", rule, err))
+			return nil, err
+		}
+		targets = append(targets, output.Targets...)
+		if output.NextToken == nil {
+			return targets, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// ListEventRules returns the rules of an event bus with their targets.
+func ListEventRules(cfg aws.Config, bus string) ([]EventRuleResp, error) {
+	client := eventbridge.NewFromConfig(cfg)
+	rules, err := listRules(client, bus)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]EventRuleResp, 0, len(rules))
+	for _, r := range rules {
+		targets, err := listRuleTargets(client, bus, aws.ToString(r.Name))
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(targets))
+		for _, t := range targets {
+			names = append(names, arnResource(aws.ToString(t.Arn)))
+		}
+		resp = append(resp, EventRuleResp{
+			Name:        aws.ToString(r.Name),
+			State:       string(r.State),
+			Trigger:     ruleTrigger(r),
+			Targets:     strings.Join(names, ","),
+			ManagedBy:   aws.ToString(r.ManagedBy),
+			Description: aws.ToString(r.Description),
+		})
+	}
+	return resp, nil
+}
+
+// ruleTrigger returns the schedule expression or the event pattern of a rule.
+func ruleTrigger(r ebTypes.Rule) string {
+	if r.ScheduleExpression != nil {
+		return *r.ScheduleExpression
+	}
+	return compactJSON(aws.ToString(r.EventPattern))
+}
+
+// GetEventRule describes a rule.
+func GetEventRule(cfg aws.Config, bus, rule string) (*eventbridge.DescribeRuleOutput, error) {
+	output, err := eventbridge.NewFromConfig(cfg).DescribeRule(context.TODO(), &eventbridge.DescribeRuleInput{
+		EventBusName: &bus,
+		Name:         &rule,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing rule %v, err: %v", rule, err))
+		return nil, err
+	}
+	return output, nil
+}
+
+// ListEventRuleTargets returns the targets of a rule.
+func ListEventRuleTargets(cfg aws.Config, bus, rule string) ([]EventTargetResp, error) {
+	targets, err := listRuleTargets(eventbridge.NewFromConfig(cfg), bus, rule)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]EventTargetResp, 0, len(targets))
+	for _, t := range targets {
+		var dlq string
+		if t.DeadLetterConfig != nil {
+			dlq = arnResourceName(aws.ToString(t.DeadLetterConfig.Arn))
+		}
+		resp = append(resp, EventTargetResp{
+			Id:         aws.ToString(t.Id),
+			Arn:        aws.ToString(t.Arn),
+			Input:      targetInput(t),
+			Role:       arnResource(aws.ToString(t.RoleArn)),
+			DeadLetter: dlq,
+			Raw:        t,
+		})
+	}
+	return resp, nil
+}
+
+// targetInput returns how the event is passed to a target.
+func targetInput(t ebTypes.Target) string {
+	switch {
+	case t.Input != nil:
+		return compactJSON(*t.Input)
+	case t.InputPath != nil:
+		return "path " + *t.InputPath
+	case t.InputTransformer != nil:
+		return "transformer " + compactJSON(aws.ToString(t.InputTransformer.InputTemplate))
+	default:
+		return "event"
+	}
+}
+
+// EnableEventRule enables a rule.
+func EnableEventRule(cfg aws.Config, bus, rule string) error {
+	_, err := eventbridge.NewFromConfig(cfg).EnableRule(context.TODO(), &eventbridge.EnableRuleInput{
+		EventBusName: &bus,
+		Name:         &rule,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error enabling rule %v, err: %v", rule, err))
+	}
+	return err
+}
+
+// DisableEventRule disables a rule.
+func DisableEventRule(cfg aws.Config, bus, rule string) error {
+	_, err := eventbridge.NewFromConfig(cfg).DisableRule(context.TODO(), &eventbridge.DisableRuleInput{
+		EventBusName: &bus,
+		Name:         &rule,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error disabling rule %v, err: %v", rule, err))
+	}
+	return err
+}
+
+// PutEvent puts a custom event on a bus and returns its id.
+func PutEvent(cfg aws.Config, bus, source, detailType, detail string) (string, error) {
+	output, err := eventbridge.NewFromConfig(cfg).PutEvents(context.TODO(), &eventbridge.PutEventsInput{
+		Entries: []ebTypes.PutEventsRequestEntry{{
+			EventBusName: &bus,
+			Source:       &source,
+			DetailType:   &detailType,
+			Detail:       &detail,
+		}},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error putting event on bus %v, err: %v", bus, err))
+		return "", err
+	}
+	if len(output.Entries) == 0 {
+		return "", fmt.Errorf("no event was put on %s", bus)
+	}
+	if e := output.Entries[0]; e.ErrorCode != nil {
+		return "", fmt.Errorf("failed to put event on %s: %s %s", bus, *e.ErrorCode, aws.ToString(e.ErrorMessage))
+	}
+	return aws.ToString(output.Entries[0].EventId), nil
+}
@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ebTypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+)
+
+func TestRuleTrigger(t *testing.T) {
+	tests := []struct {
+		rule ebTypes.Rule
+		want string
+	}{
+		{ebTypes.Rule{ScheduleExpression: aws.String("rate(5 minutes)")}, "rate(5 minutes)"},
+		{ebTypes.Rule{EventPattern: aws.String("{\n  \"source\": [\"aws.ec2\"]\n}")}, `{"source":["aws.ec2"]}`},
+	}
+	for _, tt := range tests {
+		if got := ruleTrigger(tt.rule); got != tt.want {
+			t.Errorf("ruleTrigger() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestTargetInput(t *testing.T) {
+	tests := []struct {
+		target ebTypes.Target
+		want   string
+	}{
+		{ebTypes.Target{}, "event"},
+		{ebTypes.Target{Input: aws.String(`{ "job": "nightly" }`)}, `{"job":"nightly"}`},
+		{ebTypes.Target{InputPath: aws.String("$.detail")}, "path $.detail"},
+		{ebTypes.Target{InputTransformer: &ebTypes.InputTransformer{InputTemplate: aws.String(`{"id": <id>}`)}}, `transformer {"id": <id>}`},
+	}
+	for _, tt := range tests {
+		if got := targetInput(tt.target); got != tt.want {
+			t.Errorf("targetInput() = %v, want %v", got, tt.want)
+		}
+	}
+}
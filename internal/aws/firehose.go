@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	firehoseTypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListDeliveryStreams returns the Firehose delivery streams of the region.
+func ListDeliveryStreams(cfg aws.Config) ([]DeliveryStreamResp, error) {
+	client := firehose.NewFromConfig(cfg)
+	var names []string
+	input := &firehose.ListDeliveryStreamsInput{}
+	for {
+		output, err := client.ListDeliveryStreams(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing delivery streams, err: %v", err))
+			return nil, err
+		}
+		names = append(names, output.DeliveryStreamNames...)
+		if !aws.ToBool(output.HasMoreDeliveryStreams) || len(output.DeliveryStreamNames) == 0 {
+			break
+		}
+		input.ExclusiveStartDeliveryStreamName = &names[len(names)-1]
+	}
+	streams := make([]DeliveryStreamResp, 0, len(names))
+	for _, name := range names {
+		d, err := GetDeliveryStream(cfg, name)
+		if err != nil {
+			return nil, err
+		}
+		var destination, buffering string
+		if len(d.Destinations) > 0 {
+			destination, buffering = deliveryDestination(d.Destinations[0])
+		}
+		streams = append(streams, DeliveryStreamResp{
+			Name:        name,
+			Status:      string(d.DeliveryStreamStatus),
+			Source:      deliverySource(d),
+			Destination: destination,
+			Buffering:   buffering,
+			Created:     ecsLocalTime(d.CreateTimestamp),
+		})
+	}
+	return streams, nil
+}
+
+// GetDeliveryStream describes a delivery stream.
+func GetDeliveryStream(cfg aws.Config, name string) (*firehoseTypes.DeliveryStreamDescription, error) {
+	output, err := firehose.NewFromConfig(cfg).DescribeDeliveryStream(context.TODO(), &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing delivery stream %v, err: %v", name, err))
+		return nil, err
+	}
+	return output.DeliveryStreamDescription, nil
+}
+
+func deliverySource(d *firehoseTypes.DeliveryStreamDescription) string {
+	if d.Source != nil {
+		if k := d.Source.KinesisStreamSourceDescription; k != nil {
+			return "kinesis " + arnResourceId(aws.ToString(k.KinesisStreamARN))
+		}
+		if m := d.Source.MSKSourceDescription; m != nil {
+			return "msk " + aws.ToString(m.TopicName)
+		}
+	}
+	return string(d.DeliveryStreamType)
+}
+
+// deliveryDestination returns a short description of a destination and of
+// its buffering hints when known.
+func deliveryDestination(d firehoseTypes.DestinationDescription) (string, string) {
+	switch {
+	case d.ExtendedS3DestinationDescription != nil:
+		s := d.ExtendedS3DestinationDescription
+		return s3Destination(s.BucketARN, s.Prefix), s3Buffering(s.BufferingHints)
+	case d.RedshiftDestinationDescription != nil:
+		r := d.RedshiftDestinationDescription
+		dest := "redshift " + aws.ToString(r.ClusterJDBCURL)
+		if r.CopyCommand != nil {
+			dest += " " + aws.ToString(r.CopyCommand.DataTableName)
+		}
+		return dest, ""
+	case d.AmazonopensearchserviceDestinationDescription != nil:
+		o := d.AmazonopensearchserviceDestinationDescription
+		return "opensearch " + domainIndex(o.DomainARN, o.ClusterEndpoint, o.IndexName), ""
+	case d.AmazonOpenSearchServerlessDestinationDescription != nil:
+		o := d.AmazonOpenSearchServerlessDestinationDescription
+		return "opensearch-serverless " + domainIndex(nil, o.CollectionEndpoint, o.IndexName), ""
+	case d.ElasticsearchDestinationDescription != nil:
+		e := d.ElasticsearchDestinationDescription
+		return "elasticsearch " + domainIndex(e.DomainARN, e.ClusterEndpoint, e.IndexName), ""
+	case d.SplunkDestinationDescription != nil:
+		return "splunk " + aws.ToString(d.SplunkDestinationDescription.HECEndpoint), ""
+	case d.HttpEndpointDestinationDescription != nil:
+		h := d.HttpEndpointDestinationDescription
+		if h.EndpointConfiguration == nil {
+			return "http", ""
+		}
+		if h.EndpointConfiguration.Name != nil {
+			return "http " + *h.EndpointConfiguration.Name, ""
+		}
+		return "http " + aws.ToString(h.EndpointConfiguration.Url), ""
+	case d.S3DestinationDescription != nil:
+		s := d.S3DestinationDescription
+		return s3Destination(s.BucketARN, s.Prefix), s3Buffering(s.BufferingHints)
+	}
+	return "", ""
+}
+
+func s3Destination(bucketArn, prefix *string) string {
+	return "s3://" + arnResource(aws.ToString(bucketArn)) + "/" + strings.TrimPrefix(aws.ToString(prefix), "/")
+}
+
+func s3Buffering(h *firehoseTypes.BufferingHints) string {
+	if h == nil {
+		return ""
+	}
+	return fmt.Sprintf("%dMB/%ds", aws.ToInt32(h.SizeInMBs), aws.ToInt32(h.IntervalInSeconds))
+}
+
+func domainIndex(domainArn, endpoint, index *string) string {
+	domain := aws.ToString(endpoint)
+	if domainArn != nil {
+		domain = arnResourceId(*domainArn)
+	}
+	return domain + "/" + aws.ToString(index)
+}
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	kinesisTypes "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// peekLimit caps the number of records read by a single GetRecords call.
+	peekLimit = 100
+
+	// peekPolls caps the number of empty GetRecords calls made to catch up
+	// with a shard before giving up on a read.
+	peekPolls = 5
+)
+
+// ShardPositions lists the positions a shard can be read from.
+var ShardPositions = []string{
+	string(kinesisTypes.ShardIteratorTypeTrimHorizon),
+	string(kinesisTypes.ShardIteratorTypeLatest),
+	string(kinesisTypes.ShardIteratorTypeAtTimestamp),
+}
+
+// ListKinesisStreams returns the data streams of the region.
+func ListKinesisStreams(cfg aws.Config) ([]KinesisStreamResp, error) {
+	client := kinesis.NewFromConfig(cfg)
+	var names []string
+	paginator := kinesis.NewListStreamsPaginator(client, &kinesis.ListStreamsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing kinesis streams, err: %v", err))
+			return nil, err
+		}
+		names = append(names, output.StreamNames...)
+	}
+	streams := make([]KinesisStreamResp, 0, len(names))
+	for _, name := range names {
+		name := name
+		output, err := client.DescribeStreamSummary(context.TODO(), &kinesis.DescribeStreamSummaryInput{
+			StreamName: &name,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing kinesis stream %v, err: %v", name, err))
+			return nil, err
+		}
+		s := output.StreamDescriptionSummary
+		var mode string
+		if s.StreamModeDetails != nil {
+			mode = string(s.StreamModeDetails.StreamMode)
+		}
+		streams = append(streams, KinesisStreamResp{
+			Name:       name,
+			Status:     string(s.StreamStatus),
+			Mode:       mode,
+			Shards:     strconv.Itoa(int(aws.ToInt32(s.OpenShardCount))),
+			Retention:  strconv.Itoa(int(aws.ToInt32(s.RetentionPeriodHours))) + "h",
+			Consumers:  strconv.Itoa(int(aws.ToInt32(s.ConsumerCount))),
+			Encryption: string(s.EncryptionType),
+			Created:    ecsLocalTime(s.StreamCreationTimestamp),
+		})
+	}
+	return streams, nil
+}
+
+// GetKinesisStream describes a data stream.
+func GetKinesisStream(cfg aws.Config, name string) (*kinesisTypes.StreamDescriptionSummary, error) {
+	output, err := kinesis.NewFromConfig(cfg).DescribeStreamSummary(context.TODO(), &kinesis.DescribeStreamSummaryInput{
+		StreamName: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing kinesis stream %v, err: %v", name, err))
+		return nil, err
+	}
+	return output.StreamDescriptionSummary, nil
+}
+
+// ListShards returns the shards of a data stream.
+func ListShards(cfg aws.Config, stream string) ([]ShardResp, error) {
+	client := kinesis.NewFromConfig(cfg)
+	var shards []ShardResp
+	input := &kinesis.ListShardsInput{StreamName: &stream}
+	for {
+		output, err := client.ListShards(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing shards of %v, err: %v", stream, err))
+			return nil, err
+		}
+		for _, s := range output.Shards {
+			state := "OPEN"
+			var startingSeq string
+			if r := s.SequenceNumberRange; r != nil {
+				startingSeq = aws.ToString(r.StartingSequenceNumber)
+				if r.EndingSequenceNumber != nil {
+					state = "CLOSED"
+				}
+			}
+			var startingHash, endingHash string
+			if r := s.HashKeyRange; r != nil {
+				startingHash, endingHash = aws.ToString(r.StartingHashKey), aws.ToString(r.EndingHashKey)
+			}
+			shards = append(shards, ShardResp{
+				ShardId:      aws.ToString(s.ShardId),
+				State:        state,
+				Parent:       aws.ToString(s.ParentShardId),
+				StartingHash: startingHash,
+				EndingHash:   endingHash,
+				StartingSeq:  startingSeq,
+				Raw:          s,
+			})
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input = &kinesis.ListShardsInput{NextToken: output.NextToken}
+	}
+	return shards, nil
+}
+
+// ShardReader reads the records of a shard, resuming where the previous
+// read stopped.
+type ShardReader struct {
+	client   *kinesis.Client
+	shard    string
+	mx       sync.Mutex
+	iterator *string
+}
+
+// NewShardReader returns a reader of a shard starting at position, one of
+// ShardPositions. The timestamp of AT_TIMESTAMP is either RFC3339 or a
+// duration ago such as 15m.
+func NewShardReader(cfg aws.Config, stream, shard, position, timestamp string) (*ShardReader, error) {
+	input := &kinesis.GetShardIteratorInput{
+		StreamName:        &stream,
+		ShardId:           &shard,
+		ShardIteratorType: kinesisTypes.ShardIteratorType(position),
+	}
+	if input.ShardIteratorType == kinesisTypes.ShardIteratorTypeAtTimestamp {
+		at, err := parseIteratorTime(timestamp, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		input.Timestamp = &at
+	}
+	client := kinesis.NewFromConfig(cfg)
+	output, err := client.GetShardIterator(context.TODO(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting iterator of shard %v of %v, err: %v", shard, stream, err))
+		return nil, err
+	}
+	return &ShardReader{client: client, shard: shard, iterator: output.ShardIterator}, nil
+}
+
+func parseIteratorTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(strings.TrimSuffix(s, "ago")))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q, expected RFC3339 or a duration such as 15m", s)
+	}
+	return now.Add(-d), nil
+}
+
+// Read returns the next records of the shard as text lines, nothing once a
+// closed shard has been read to its end. Concurrent reads are serialized.
+func (r *ShardReader) Read(ctx context.Context) ([]string, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	var lines []string
+	for i := 0; i < peekPolls && r.iterator != nil; i++ {
+		output, err := r.client.GetRecords(ctx, &kinesis.GetRecordsInput{
+			ShardIterator: r.iterator,
+			Limit:         aws.Int32(peekLimit),
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting records of shard %v, err: %v", r.shard, err))
+			return nil, err
+		}
+		r.iterator = output.NextShardIterator
+		for _, rec := range output.Records {
+			lines = append(lines, formatRecord(rec)...)
+		}
+		if len(output.Records) > 0 || aws.ToInt64(output.MillisBehindLatest) == 0 {
+			break
+		}
+	}
+	return lines, nil
+}
+
+func formatRecord(r kinesisTypes.Record) []string {
+	header := fmt.Sprintf("# %s  %s  key=%s", aws.ToString(r.SequenceNumber), ecsLocalTime(r.ApproximateArrivalTimestamp), aws.ToString(r.PartitionKey))
+	lines := append([]string{header}, strings.Split(decodeRecord(r.Data), "\n")...)
+	return append(lines, "")
+}
+
+// decodeRecord returns the data of a record as text, gunzipped when
+// compressed, indented when JSON and base64 encoded when binary.
+func decodeRecord(data []byte) string {
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		if zr, err := gzip.NewReader(bytes.NewReader(data)); err == nil {
+			if unzipped, err := io.ReadAll(zr); err == nil {
+				data = unzipped
+			}
+		}
+	}
+	switch {
+	case json.Valid(data):
+		return indentJSON(string(data))
+	case utf8.Valid(data):
+		return string(data)
+	default:
+		return "base64:" + base64.StdEncoding.EncodeToString(data)
+	}
+}
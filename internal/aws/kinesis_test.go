@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	firehoseTypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+)
+
+func TestParseIteratorTime(t *testing.T) {
+	now := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2023-01-02T10:00:00Z", time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)},
+		{"15m", now.Add(-15 * time.Minute)},
+		{" 2h ago ", now.Add(-2 * time.Hour)},
+	}
+	for _, tt := range tests {
+		got, err := parseIteratorTime(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseIteratorTime(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseIteratorTime("yesterday", now); err == nil {
+		t.Errorf("parseIteratorTime(yesterday) should fail")
+	}
+}
+
+func TestDecodeRecord(t *testing.T) {
+	var zipped bytes.Buffer
+	zw := gzip.NewWriter(&zipped)
+	zw.Write([]byte(`{"level":"info"}`))
+	zw.Close()
+
+	tests := []struct {
+		data []byte
+		want string
+	}{
+		{[]byte(`{"id":1}`), "{\n  \"id\": 1\n}"},
+		{[]byte("plain text"), "plain text"},
+		{zipped.Bytes(), "{\n  \"level\": \"info\"\n}"},
+		{[]byte{0xff, 0xfe, 0x00}, "base64://4A"},
+	}
+	for _, tt := range tests {
+		if got := decodeRecord(tt.data); got != tt.want {
+			t.Errorf("decodeRecord(%v) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestDeliveryDestination(t *testing.T) {
+	tests := []struct {
+		dest      firehoseTypes.DestinationDescription
+		want, buf string
+	}{
+		{
+			firehoseTypes.DestinationDescription{ExtendedS3DestinationDescription: &firehoseTypes.ExtendedS3DestinationDescription{
+				BucketARN:      aws.String("arn:aws:s3:::logs"),
+				Prefix:         aws.String("firehose/"),
+				BufferingHints: &firehoseTypes.BufferingHints{SizeInMBs: aws.Int32(5), IntervalInSeconds: aws.Int32(300)},
+			}},
+			"s3://logs/firehose/", "5MB/300s",
+		},
+		{
+			firehoseTypes.DestinationDescription{AmazonopensearchserviceDestinationDescription: &firehoseTypes.AmazonopensearchserviceDestinationDescription{
+				DomainARN: aws.String("arn:aws:es:us-east-1:123:domain/search"),
+				IndexName: aws.String("events"),
+			}},
+			"opensearch search/events", "",
+		},
+		{
+			firehoseTypes.DestinationDescription{HttpEndpointDestinationDescription: &firehoseTypes.HttpEndpointDestinationDescription{
+				EndpointConfiguration: &firehoseTypes.HttpEndpointDescription{Url: aws.String("https://example.com/ingest")},
+			}},
+			"http https://example.com/ingest", "",
+		},
+	}
+	for _, tt := range tests {
+		if got, buf := deliveryDestination(tt.dest); got != tt.want || buf != tt.buf {
+			t.Errorf("deliveryDestination() = %v, %v, want %v, %v", got, buf, tt.want, tt.buf)
+		}
+	}
+}
+
+func TestDeliverySource(t *testing.T) {
+	d := &firehoseTypes.DeliveryStreamDescription{DeliveryStreamType: firehoseTypes.DeliveryStreamTypeDirectPut}
+	if got := deliverySource(d); got != "DirectPut" {
+		t.Errorf("deliverySource() = %v", got)
+	}
+	d.Source = &firehoseTypes.SourceDescription{KinesisStreamSourceDescription: &firehoseTypes.KinesisStreamSourceDescription{
+		KinesisStreamARN: aws.String("arn:aws:kinesis:us-east-1:123:stream/clicks"),
+	}}
+	if got := deliverySource(d); got != "kinesis clicks" {
+		t.Errorf("deliverySource() = %v", got)
+	}
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListKmsKeys returns the KMS keys of the region with their aliases.
+func ListKmsKeys(cfg aws.Config) ([]KmsKeyResp, error) {
+	client := kms.NewFromConfig(cfg)
+	aliases, err := listKmsAliases(client)
+	if err != nil {
+		return nil, err
+	}
+	var keys []KmsKeyResp
+	paginator := kms.NewListKeysPaginator(client, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing kms keys, err: %v", err))
+			return nil, err
+		}
+		for _, k := range output.Keys {
+			key, err := client.DescribeKey(context.TODO(), &kms.DescribeKeyInput{KeyId: k.KeyId})
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error describing kms key %v, err: %v", aws.ToString(k.KeyId), err))
+				return nil, err
+			}
+			m := key.KeyMetadata
+			keys = append(keys, KmsKeyResp{
+				KeyId:     aws.ToString(m.KeyId),
+				Alias:     strings.Join(aliases[aws.ToString(m.KeyId)], ","),
+				State:     string(m.KeyState),
+				Spec:      string(m.KeySpec),
+				Rotation:  kmsKeyRotation(client, m),
+				Manager:   string(m.KeyManager),
+				CreatedAt: ecsLocalTime(m.CreationDate),
+			})
+		}
+	}
+	return keys, nil
+}
+
+// listKmsAliases returns the aliases of the region by target key id.
+func listKmsAliases(client *kms.Client) (map[string][]string, error) {
+	aliases := make(map[string][]string)
+	paginator := kms.NewListAliasesPaginator(client, &kms.ListAliasesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing kms aliases, err: %v", err))
+			return nil, err
+		}
+		for _, a := range output.Aliases {
+			if a.TargetKeyId == nil {
+				continue
+			}
+			aliases[*a.TargetKeyId] = append(aliases[*a.TargetKeyId], aws.ToString(a.AliasName))
+		}
+	}
+	return aliases, nil
+}
+
+// kmsKeyRotation returns whether automatic rotation is enabled, only
+// symmetric keys with KMS generated material support it.
+func kmsKeyRotation(client *kms.Client, m *kmsTypes.KeyMetadata) string {
+	if !kmsKeyRotates(m) {
+		return "-"
+	}
+	output, err := client.GetKeyRotationStatus(context.TODO(), &kms.GetKeyRotationStatusInput{KeyId: m.KeyId})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting rotation status of kms key %v, err: %v", aws.ToString(m.KeyId), err))
+		return "-"
+	}
+	if output.KeyRotationEnabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+func kmsKeyRotates(m *kmsTypes.KeyMetadata) bool {
+	if m.KeySpec != kmsTypes.KeySpecSymmetricDefault || m.Origin != kmsTypes.OriginTypeAwsKms {
+		return false
+	}
+	return m.KeyState == kmsTypes.KeyStateEnabled || m.KeyState == kmsTypes.KeyStateDisabled
+}
+
+// KmsKeyDescription is the description of a KMS key with its key policy.
+type KmsKeyDescription struct {
+	KeyMetadata *kmsTypes.KeyMetadata
+	Aliases     []string
+	Rotation    string
+	KeyPolicy   interface{}
+}
+
+// GetKmsKey describes a KMS key with its aliases and default key policy.
+func GetKmsKey(cfg aws.Config, keyId string) (*KmsKeyDescription, error) {
+	client := kms.NewFromConfig(cfg)
+	key, err := client.DescribeKey(context.TODO(), &kms.DescribeKeyInput{KeyId: &keyId})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing kms key %v, err: %v", keyId, err))
+		return nil, err
+	}
+	desc := KmsKeyDescription{
+		KeyMetadata: key.KeyMetadata,
+		Rotation:    kmsKeyRotation(client, key.KeyMetadata),
+	}
+	aliases, err := client.ListAliases(context.TODO(), &kms.ListAliasesInput{KeyId: &keyId})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error listing aliases of kms key %v, err: %v", keyId, err))
+		return nil, err
+	}
+	for _, a := range aliases.Aliases {
+		desc.Aliases = append(desc.Aliases, aws.ToString(a.AliasName))
+	}
+	policy, err := client.GetKeyPolicy(context.TODO(), &kms.GetKeyPolicyInput{KeyId: &keyId, PolicyName: aws.String("default")})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting policy of kms key %v, err: %v", keyId, err))
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(aws.ToString(policy.Policy)), &desc.KeyPolicy); err != nil {
+		desc.KeyPolicy = aws.ToString(policy.Policy)
+	}
+	return &desc, nil
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+func TestKmsKeyRotates(t *testing.T) {
+	uu := map[string]struct {
+		key  kmsTypes.KeyMetadata
+		want bool
+	}{
+		"symmetric": {key: kmsTypes.KeyMetadata{KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms, KeyState: kmsTypes.KeyStateEnabled}, want: true},
+		"disabled":  {key: kmsTypes.KeyMetadata{KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms, KeyState: kmsTypes.KeyStateDisabled}, want: true},
+		"deleting":  {key: kmsTypes.KeyMetadata{KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms, KeyState: kmsTypes.KeyStatePendingDeletion}},
+		"imported":  {key: kmsTypes.KeyMetadata{KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeExternal, KeyState: kmsTypes.KeyStateEnabled}},
+		"rsa":       {key: kmsTypes.KeyMetadata{KeySpec: kmsTypes.KeySpecRsa2048, Origin: kmsTypes.OriginTypeAwsKms, KeyState: kmsTypes.KeyStateEnabled}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := kmsKeyRotates(&u.key); got != u.want {
+				t.Errorf("kmsKeyRotates() = %v, want %v", got, u.want)
+			}
+		})
+	}
+}
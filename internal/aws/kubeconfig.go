@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+const kubeAuthAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+// kubeConfig represents a kubeconfig file, keeping fields cloudlens does not manage.
+type kubeConfig struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []yaml.MapSlice        `yaml:"clusters"`
+	Contexts       []yaml.MapSlice        `yaml:"contexts"`
+	Users          []yaml.MapSlice        `yaml:"users"`
+	CurrentContext string                 `yaml:"current-context"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+// KubeConfigPath returns the kubeconfig file kubectl reads first.
+func KubeConfigPath() (string, error) {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && paths[0] != "" {
+		return paths[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// WriteEksKubeConfig writes or merges a context for an EKS cluster into the
+// kubeconfig at path, authenticating through aws eks get-token like
+// aws eks update-kubeconfig does. It returns the context name.
+func WriteEksKubeConfig(path string, c EksClusterResp, region, profile string) (string, error) {
+	kc := kubeConfig{APIVersion: "v1", Kind: "Config"}
+	b, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(b, &kc); err != nil {
+			return "", fmt.Errorf("unable to parse kubeconfig %s: %w", path, err)
+		}
+	case !os.IsNotExist(err):
+		return "", err
+	}
+
+	name := c.Arn
+	exec := yaml.MapSlice{
+		{Key: "apiVersion", Value: kubeAuthAPIVersion},
+		{Key: "command", Value: "aws"},
+		{Key: "args", Value: []string{"--region", region, "eks", "get-token", "--cluster-name", c.Name, "--output", "json"}},
+	}
+	if profile != "" {
+		exec = append(exec, yaml.MapItem{Key: "env", Value: []yaml.MapSlice{{
+			{Key: "name", Value: "AWS_PROFILE"},
+			{Key: "value", Value: profile},
+		}}})
+	}
+	kc.Clusters = upsertNamed(kc.Clusters, name, "cluster", yaml.MapSlice{
+		{Key: "server", Value: c.Endpoint},
+		{Key: "certificate-authority-data", Value: c.CertificateAuthority},
+	})
+	kc.Users = upsertNamed(kc.Users, name, "user", yaml.MapSlice{{Key: "exec", Value: exec}})
+	kc.Contexts = upsertNamed(kc.Contexts, name, "context", yaml.MapSlice{
+		{Key: "cluster", Value: name},
+		{Key: "user", Value: name},
+	})
+	kc.CurrentContext = name
+
+	out, err := yaml.Marshal(kc)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	return name, os.WriteFile(path, out, 0600)
+}
+
+// upsertNamed replaces or appends a named kubeconfig entry.
+func upsertNamed(entries []yaml.MapSlice, name, key string, value yaml.MapSlice) []yaml.MapSlice {
+	entry := yaml.MapSlice{{Key: "name", Value: name}, {Key: key, Value: value}}
+	for i, e := range entries {
+		for _, item := range e {
+			if item.Key == "name" && item.Value == name {
+				entries[i] = entry
+				return entries
+			}
+		}
+	}
+	return append(entries, entry)
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestWriteEksKubeConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	existing := `apiVersion: v1
+kind: Config
+clusters:
+- name: kind
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: kind
+  context:
+    cluster: kind
+    user: kind
+users:
+- name: kind
+  user:
+    token: secret
+current-context: kind
+preferences: {}
+`
+	if err := os.WriteFile(path, []byte(existing), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c := EksClusterResp{Name: "prod", Arn: "arn:aws:eks:us-east-1:123456789012:cluster/prod", Endpoint: "https://prod.eks", CertificateAuthority: "Y2E="}
+
+	for i := 0; i < 2; i++ {
+		name, err := WriteEksKubeConfig(path, c, "us-east-1", "dev")
+		if err != nil {
+			t.Fatalf("WriteEksKubeConfig() err = %v", err)
+		}
+		if name != c.Arn {
+			t.Errorf("WriteEksKubeConfig() context = %v, want %v", name, c.Arn)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kc kubeConfig
+	if err := yaml.Unmarshal(b, &kc); err != nil {
+		t.Fatal(err)
+	}
+	if len(kc.Clusters) != 2 || len(kc.Contexts) != 2 || len(kc.Users) != 2 {
+		t.Errorf("expected 2 clusters, contexts and users, got %d, %d, %d", len(kc.Clusters), len(kc.Contexts), len(kc.Users))
+	}
+	if kc.CurrentContext != c.Arn {
+		t.Errorf("current-context = %v, want %v", kc.CurrentContext, c.Arn)
+	}
+	if _, ok := kc.Extra["preferences"]; !ok {
+		t.Error("expected preferences to be kept")
+	}
+	for _, want := range []string{"token: secret", "server: https://prod.eks", "- get-token", "value: dev"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("expected kubeconfig to contain %q", want)
+		}
+	}
+}
@@ -2,12 +2,17 @@ package aws
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/one2nc/cloudlens/internal/config"
 	"github.com/rs/zerolog/log"
 )
@@ -47,3 +52,261 @@ func GetAllLambdaFunctions(cfg awsV2.Config) ([]LambdaResp, error) {
 	}
 	return responseA, nil
 }
+
+// InvokeLambda invokes a function synchronously, tailing its logs, or asynchronously.
+func InvokeLambda(cfg awsV2.Config, functionName string, payload []byte, async bool) (*LambdaInvokeResp, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	input := &lambda.InvokeInput{
+		FunctionName:   &functionName,
+		Payload:        payload,
+		InvocationType: types.InvocationTypeRequestResponse,
+		LogType:        types.LogTypeTail,
+	}
+	if async {
+		input.InvocationType = types.InvocationTypeEvent
+		input.LogType = types.LogTypeNone
+	}
+	out, err := lambdaServ.Invoke(context.Background(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error invoking Lambda function %v: %v", functionName, err))
+		return nil, err
+	}
+	resp := &LambdaInvokeResp{
+		InvocationType:  string(input.InvocationType),
+		StatusCode:      out.StatusCode,
+		FunctionError:   awsV2.ToString(out.FunctionError),
+		ExecutedVersion: awsV2.ToString(out.ExecutedVersion),
+		Payload:         string(out.Payload),
+	}
+	if out.LogResult != nil {
+		logs, err := base64.StdEncoding.DecodeString(*out.LogResult)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error decoding log result of %v: %v", functionName, err))
+		}
+		resp.LogTail = string(logs)
+	}
+	return resp, nil
+}
+
+// lambdaLocalTime formats a lambda ISO-8601 timestamp in the local timezone.
+func lambdaLocalTime(ts string) string {
+	t, err := time.Parse("2006-01-02T15:04:05.999-0700", ts)
+	if err != nil {
+		return ts
+	}
+	localZone, _ := config.GetLocalTimeZone()
+	loc, _ := time.LoadLocation(localZone)
+	return t.In(loc).Format("Mon Jan _2 15:04:05 2006")
+}
+
+// GetLambdaFunction returns a function configuration and concurrency settings.
+func GetLambdaFunction(cfg awsV2.Config, functionName, qualifier string) (*lambda.GetFunctionOutput, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	input := &lambda.GetFunctionInput{FunctionName: &functionName}
+	if qualifier != "" {
+		input.Qualifier = &qualifier
+	}
+	out, err := lambdaServ.GetFunction(context.Background(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting Lambda function %v: %v", functionName, err))
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetLambdaProvisionedConcurrency returns the provisioned concurrency configs of a function.
+func GetLambdaProvisionedConcurrency(cfg awsV2.Config, functionName string) ([]types.ProvisionedConcurrencyConfigListItem, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	var items []types.ProvisionedConcurrencyConfigListItem
+	p := lambda.NewListProvisionedConcurrencyConfigsPaginator(lambdaServ, &lambda.ListProvisionedConcurrencyConfigsInput{FunctionName: &functionName})
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting provisioned concurrency of %v: %v", functionName, err))
+			return nil, err
+		}
+		items = append(items, page.ProvisionedConcurrencyConfigs...)
+	}
+	return items, nil
+}
+
+// ListLambdaVersions returns the published versions of a function.
+func ListLambdaVersions(cfg awsV2.Config, functionName string) ([]LambdaVersionResp, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	versions := []LambdaVersionResp{}
+	p := lambda.NewListVersionsByFunctionPaginator(lambdaServ, &lambda.ListVersionsByFunctionInput{FunctionName: &functionName})
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing versions of %v: %v", functionName, err))
+			return nil, err
+		}
+		for _, v := range page.Versions {
+			versions = append(versions, LambdaVersionResp{
+				Version:      awsV2.ToString(v.Version),
+				Description:  awsV2.ToString(v.Description),
+				Runtime:      string(v.Runtime),
+				CodeSize:     strconv.Itoa(int(v.CodeSize)),
+				CodeSha256:   awsV2.ToString(v.CodeSha256),
+				LastModified: lambdaLocalTime(awsV2.ToString(v.LastModified)),
+			})
+		}
+	}
+	return versions, nil
+}
+
+// ListLambdaAliases returns the aliases of a function.
+func ListLambdaAliases(cfg awsV2.Config, functionName string) ([]LambdaAliasResp, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	aliases := []LambdaAliasResp{}
+	p := lambda.NewListAliasesPaginator(lambdaServ, &lambda.ListAliasesInput{FunctionName: &functionName})
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing aliases of %v: %v", functionName, err))
+			return nil, err
+		}
+		for _, a := range page.Aliases {
+			aliases = append(aliases, LambdaAliasResp{
+				Name:            awsV2.ToString(a.Name),
+				FunctionVersion: awsV2.ToString(a.FunctionVersion),
+				Routing:         aliasRouting(awsV2.ToString(a.FunctionVersion), a.RoutingConfig),
+				Description:     awsV2.ToString(a.Description),
+				AliasArn:        awsV2.ToString(a.AliasArn),
+			})
+		}
+	}
+	return aliases, nil
+}
+
+// aliasRouting renders an alias traffic split, i.e 1=90%,2=10%.
+func aliasRouting(version string, rc *types.AliasRoutingConfiguration) string {
+	if rc == nil || len(rc.AdditionalVersionWeights) == 0 {
+		return version + "=100%"
+	}
+	rest := 1.0
+	vv := make([]string, 0, len(rc.AdditionalVersionWeights))
+	for v := range rc.AdditionalVersionWeights {
+		vv = append(vv, v)
+	}
+	sort.Strings(vv)
+	weights := make([]string, 0, len(vv)+1)
+	for _, v := range vv {
+		w := rc.AdditionalVersionWeights[v]
+		rest -= w
+		weights = append(weights, v+"="+percent(w))
+	}
+	return strings.Join(append([]string{version + "=" + percent(rest)}, weights...), ",")
+}
+
+func percent(w float64) string {
+	return strconv.FormatFloat(math.Round(w*10000)/100, 'f', -1, 64) + "%"
+}
+
+// GetLambdaAlias returns an alias configuration.
+func GetLambdaAlias(cfg awsV2.Config, functionName, alias string) (*lambda.GetAliasOutput, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	out, err := lambdaServ.GetAlias(context.Background(), &lambda.GetAliasInput{FunctionName: &functionName, Name: &alias})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting alias %v of %v: %v", alias, functionName, err))
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListLambdaEventSources returns the event source mappings of a function.
+func ListLambdaEventSources(cfg awsV2.Config, functionName string) ([]LambdaEventSourceResp, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	sources := []LambdaEventSourceResp{}
+	p := lambda.NewListEventSourceMappingsPaginator(lambdaServ, &lambda.ListEventSourceMappingsInput{FunctionName: &functionName})
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing event sources of %v: %v", functionName, err))
+			return nil, err
+		}
+		for _, m := range page.EventSourceMappings {
+			es := LambdaEventSourceResp{
+				UUID:           awsV2.ToString(m.UUID),
+				Source:         eventSourceType(awsV2.ToString(m.EventSourceArn)),
+				EventSourceArn: awsV2.ToString(m.EventSourceArn),
+				State:          awsV2.ToString(m.State),
+				LastResult:     awsV2.ToString(m.LastProcessingResult),
+			}
+			if m.BatchSize != nil {
+				es.BatchSize = strconv.Itoa(int(*m.BatchSize))
+			}
+			if m.LastModified != nil {
+				localZone, _ := config.GetLocalTimeZone()
+				loc, _ := time.LoadLocation(localZone)
+				es.LastModified = m.LastModified.In(loc).Format("Mon Jan _2 15:04:05 2006")
+			}
+			sources = append(sources, es)
+		}
+	}
+	return sources, nil
+}
+
+// eventSourceType returns the service name of an event source arn, i.e sqs.
+func eventSourceType(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
+
+// GetLambdaEventSource returns an event source mapping configuration.
+func GetLambdaEventSource(cfg awsV2.Config, uuid string) (*lambda.GetEventSourceMappingOutput, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	out, err := lambdaServ.GetEventSourceMapping(context.Background(), &lambda.GetEventSourceMappingInput{UUID: &uuid})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting event source mapping %v: %v", uuid, err))
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetLambdaEventSourceEnabled enables or disables an event source mapping.
+func SetLambdaEventSourceEnabled(cfg awsV2.Config, uuid string, enabled bool) error {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	_, err := lambdaServ.UpdateEventSourceMapping(context.Background(), &lambda.UpdateEventSourceMappingInput{
+		UUID:    &uuid,
+		Enabled: &enabled,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error updating event source mapping %v: %v", uuid, err))
+	}
+	return err
+}
+
+// ListLambdaLayers returns the layers attached to a function.
+func ListLambdaLayers(cfg awsV2.Config, functionName string) ([]LambdaLayerResp, error) {
+	fn, err := GetLambdaFunction(cfg, functionName, "")
+	if err != nil {
+		return nil, err
+	}
+	layers := []LambdaLayerResp{}
+	for _, l := range fn.Configuration.Layers {
+		arn := awsV2.ToString(l.Arn)
+		// arn:aws:lambda:region:account:layer:name:version
+		parts := strings.Split(arn, ":")
+		lr := LambdaLayerResp{Arn: arn, CodeSize: strconv.Itoa(int(l.CodeSize))}
+		if len(parts) == 8 {
+			lr.Name, lr.Version = parts[6], parts[7]
+		}
+		layers = append(layers, lr)
+	}
+	return layers, nil
+}
+
+// GetLambdaLayerVersion returns a layer version given its arn.
+func GetLambdaLayerVersion(cfg awsV2.Config, arn string) (*lambda.GetLayerVersionByArnOutput, error) {
+	lambdaServ := lambda.NewFromConfig(cfg)
+	out, err := lambdaServ.GetLayerVersionByArn(context.Background(), &lambda.GetLayerVersionByArnInput{Arn: &arn})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting layer version %v: %v", arn, err))
+		return nil, err
+	}
+	return out, nil
+}
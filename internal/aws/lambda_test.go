@@ -79,3 +79,30 @@ func TestGetAllLambdaFunctions(t *testing.T) {
 		})
 	}
 }
+
+func TestAliasRouting(t *testing.T) {
+	cases := []struct {
+		version string
+		rc      *types.AliasRoutingConfiguration
+		want    string
+	}{
+		{version: "3", want: "3=100%"},
+		{version: "3", rc: &types.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]float64{"4": 0.1}}, want: "3=90%,4=10%"},
+	}
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if got := aliasRouting(c.version, c.rc); got != c.want {
+				t.Errorf("expected %q but got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestEventSourceType(t *testing.T) {
+	if got := eventSourceType("arn:aws:sqs:us-east-1:000000000000:orders"); got != "sqs" {
+		t.Errorf("expected sqs but got %q", got)
+	}
+	if got := eventSourceType("bogus"); got != "" {
+		t.Errorf("expected empty source but got %q", got)
+	}
+}
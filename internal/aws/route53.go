@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/rs/zerolog/log"
+)
+
+// ListHostedZones returns the public and private hosted zones of the account.
+func ListHostedZones(cfg aws.Config) ([]HostedZoneResp, error) {
+	var zones []HostedZoneResp
+	paginator := route53.NewListHostedZonesPaginator(route53.NewFromConfig(cfg), &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing hosted zones, err: %v", err))
+			return nil, err
+		}
+		for _, z := range output.HostedZones {
+			zone := HostedZoneResp{
+				Id:      hostedZoneId(aws.ToString(z.Id)),
+				Name:    aws.ToString(z.Name),
+				Type:    "Public",
+				Records: strconv.FormatInt(aws.ToInt64(z.ResourceRecordSetCount), 10),
+			}
+			if z.Config != nil {
+				zone.Comment = aws.ToString(z.Config.Comment)
+				if z.Config.PrivateZone {
+					zone.Type = "Private"
+				}
+			}
+			zones = append(zones, zone)
+		}
+	}
+	return zones, nil
+}
+
+// hostedZoneId strips the /hostedzone/ prefix of a zone id.
+func hostedZoneId(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
+
+// GetHostedZone describes a hosted zone along with its VPCs and name servers.
+func GetHostedZone(cfg aws.Config, id string) (*HostedZoneDescription, error) {
+	output, err := route53.NewFromConfig(cfg).GetHostedZone(context.TODO(), &route53.GetHostedZoneInput{Id: &id})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing hosted zone %v, err: %v", id, err))
+		return nil, err
+	}
+	return &HostedZoneDescription{
+		HostedZone:    output.HostedZone,
+		DelegationSet: output.DelegationSet,
+		VPCs:          output.VPCs,
+	}, nil
+}
+
+// ListRecordSets returns the record sets of a hosted zone.
+func ListRecordSets(cfg aws.Config, zoneId string) ([]RecordSetResp, error) {
+	rr, err := listResourceRecordSets(cfg, zoneId, "")
+	if err != nil {
+		return nil, err
+	}
+	records := make([]RecordSetResp, len(rr))
+	for i, r := range rr {
+		records[i] = recordSet(r)
+	}
+	return records, nil
+}
+
+// GetRecordSet returns the record set with the given name, type and routing
+// policy.
+func GetRecordSet(cfg aws.Config, zoneId, name, rtype, routing string) (RecordSetResp, error) {
+	rr, err := listResourceRecordSets(cfg, zoneId, name)
+	if err != nil {
+		return RecordSetResp{}, err
+	}
+	for _, r := range rr {
+		if record := recordSet(r); record.Type == rtype && record.Routing == routing {
+			return record, nil
+		}
+	}
+	return RecordSetResp{}, fmt.Errorf("record set %s %s not found", name, rtype)
+}
+
+func recordSet(r r53Types.ResourceRecordSet) RecordSetResp {
+	record := RecordSetResp{
+		Name:          recordName(r.Name),
+		Type:          string(r.Type),
+		Values:        recordValues(r),
+		Routing:       routingPolicy(r),
+		HealthCheckId: aws.ToString(r.HealthCheckId),
+	}
+	if r.TTL != nil {
+		record.TTL = strconv.FormatInt(*r.TTL, 10)
+	}
+	if a := r.AliasTarget; a != nil {
+		record.Alias = aws.ToString(a.DNSName)
+	}
+	return record
+}
+
+func listResourceRecordSets(cfg aws.Config, zoneId, name string) ([]r53Types.ResourceRecordSet, error) {
+	var rr []r53Types.ResourceRecordSet
+	client := route53.NewFromConfig(cfg)
+	input := &route53.ListResourceRecordSetsInput{HostedZoneId: &zoneId}
+	if name != "" {
+		input.StartRecordName = &name
+	}
+	for {
+		output, err := client.ListResourceRecordSets(context.TODO(), input)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing record sets of %v, err: %v", zoneId, err))
+			return nil, err
+		}
+		for _, r := range output.ResourceRecordSets {
+			if name != "" && recordName(r.Name) != name {
+				return rr, nil
+			}
+			rr = append(rr, r)
+		}
+		if !output.IsTruncated {
+			break
+		}
+		input.StartRecordName, input.StartRecordType, input.StartRecordIdentifier = output.NextRecordName, output.NextRecordType, output.NextRecordIdentifier
+	}
+	return rr, nil
+}
+
+// recordName unescapes the wildcard of a record name, \052.example.com.
+func recordName(name *string) string {
+	return strings.ReplaceAll(aws.ToString(name), `\052`, "*")
+}
+
+func recordValues(r r53Types.ResourceRecordSet) []string {
+	values := make([]string, len(r.ResourceRecords))
+	for i, v := range r.ResourceRecords {
+		values[i] = aws.ToString(v.Value)
+	}
+	return values
+}
+
+// routingPolicy returns the routing policy of a record set i.e weighted:10.
+func routingPolicy(r r53Types.ResourceRecordSet) string {
+	switch {
+	case r.Weight != nil:
+		return fmt.Sprintf("weighted:%d", *r.Weight)
+	case r.Region != "":
+		return "latency:" + string(r.Region)
+	case r.Failover != "":
+		return "failover:" + string(r.Failover)
+	case r.GeoLocation != nil:
+		g := r.GeoLocation
+		for _, code := range []*string{g.SubdivisionCode, g.CountryCode, g.ContinentCode} {
+			if code != nil {
+				return "geolocation:" + *code
+			}
+		}
+		return "geolocation"
+	case r.CidrRoutingConfig != nil:
+		return "ip-based:" + aws.ToString(r.CidrRoutingConfig.LocationName)
+	case aws.ToBool(r.MultiValueAnswer):
+		return "multivalue"
+	default:
+		return "simple"
+	}
+}
+
+// GetRecordSets describes the record sets with the given name along with
+// their health checks and the latest health check observations.
+func GetRecordSets(cfg aws.Config, zoneId, name string) (*RecordSetDescription, error) {
+	rr, err := listResourceRecordSets(cfg, zoneId, name)
+	if err != nil {
+		return nil, err
+	}
+	desc := RecordSetDescription{RecordSets: rr}
+	client := route53.NewFromConfig(cfg)
+	for _, r := range rr {
+		if r.HealthCheckId == nil {
+			continue
+		}
+		hc, err := client.GetHealthCheck(context.TODO(), &route53.GetHealthCheckInput{HealthCheckId: r.HealthCheckId})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing health check %v, err: %v", *r.HealthCheckId, err))
+			return nil, err
+		}
+		status, err := client.GetHealthCheckStatus(context.TODO(), &route53.GetHealthCheckStatusInput{HealthCheckId: r.HealthCheckId})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting health check status %v, err: %v", *r.HealthCheckId, err))
+			return nil, err
+		}
+		desc.HealthChecks = append(desc.HealthChecks, HealthCheckDescription{
+			HealthCheck:  hc.HealthCheck,
+			Observations: status.HealthCheckObservations,
+		})
+	}
+	return &desc, nil
+}
+
+// ResolveRecord queries the local resolver for a record and compares the
+// answer with the configured values. Alias records are compared with the
+// answer for their alias target.
+func ResolveRecord(ctx context.Context, r RecordSetResp) (*RecordResolution, error) {
+	configured := r.Values
+	if r.Alias != "" {
+		var err error
+		if configured, err = lookupRecord(ctx, net.DefaultResolver, r.Alias, r.Type); err != nil {
+			return nil, fmt.Errorf("failed to resolve alias target %s: %w", r.Alias, err)
+		}
+	}
+	resolved, err := lookupRecord(ctx, net.DefaultResolver, r.Name, r.Type)
+	if err != nil {
+		return nil, err
+	}
+	res := RecordResolution{Name: r.Name, Type: r.Type, Configured: configured, Resolved: resolved}
+	res.Missing, res.Unexpected = compareValues(configured, resolved)
+	return &res, nil
+}
+
+func lookupRecord(ctx context.Context, resolver *net.Resolver, name, rtype string) ([]string, error) {
+	// Wildcard records are probed through an arbitrary label.
+	host := strings.ReplaceAll(name, "*", "wildcard-probe")
+	var values []string
+	switch rtype {
+	case "A", "AAAA":
+		network := "ip4"
+		if rtype == "AAAA" {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			values = append(values, ip.String())
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, cname)
+	case "MX":
+		mxs, err := resolver.LookupMX(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range mxs {
+			values = append(values, fmt.Sprintf("%d %s", mx.Pref, mx.Host))
+		}
+	case "NS":
+		nss, err := resolver.LookupNS(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range nss {
+			values = append(values, ns.Host)
+		}
+	case "TXT":
+		txts, err := resolver.LookupTXT(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, txts...)
+	default:
+		return nil, fmt.Errorf("resolving %s records is not supported", rtype)
+	}
+	return values, nil
+}
+
+// compareValues returns the configured values missing from the answer and
+// the answered values that are not configured.
+func compareValues(configured, resolved []string) ([]string, []string) {
+	want, got := make(map[string]struct{}), make(map[string]struct{})
+	for _, v := range configured {
+		want[normalizeValue(v)] = struct{}{}
+	}
+	for _, v := range resolved {
+		got[normalizeValue(v)] = struct{}{}
+	}
+	var missing, unexpected []string
+	for v := range want {
+		if _, ok := got[v]; !ok {
+			missing = append(missing, v)
+		}
+	}
+	for v := range got {
+		if _, ok := want[v]; !ok {
+			unexpected = append(unexpected, v)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	return missing, unexpected
+}
+
+// normalizeValue drops the case, trailing dots and TXT quoting of a value.
+func normalizeValue(v string) string {
+	v = strings.ReplaceAll(strings.TrimSpace(v), `" "`, "")
+	v = strings.Trim(v, `"`)
+	return strings.TrimSuffix(strings.ToLower(v), ".")
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func TestRoutingPolicy(t *testing.T) {
+	uu := map[string]struct {
+		record r53Types.ResourceRecordSet
+		want   string
+	}{
+		"simple":   {record: r53Types.ResourceRecordSet{}, want: "simple"},
+		"weighted": {record: r53Types.ResourceRecordSet{Weight: aws.Int64(10)}, want: "weighted:10"},
+		"latency":  {record: r53Types.ResourceRecordSet{Region: r53Types.ResourceRecordSetRegionEuWest1}, want: "latency:eu-west-1"},
+		"failover": {record: r53Types.ResourceRecordSet{Failover: r53Types.ResourceRecordSetFailoverPrimary}, want: "failover:PRIMARY"},
+		"geo":      {record: r53Types.ResourceRecordSet{GeoLocation: &r53Types.GeoLocation{CountryCode: aws.String("DE")}}, want: "geolocation:DE"},
+		"multi":    {record: r53Types.ResourceRecordSet{MultiValueAnswer: aws.Bool(true)}, want: "multivalue"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := routingPolicy(u.record); got != u.want {
+				t.Errorf("routingPolicy() = %q, want %q", got, u.want)
+			}
+		})
+	}
+}
+
+func TestRecordName(t *testing.T) {
+	if got := recordName(aws.String(`\052.example.com.`)); got != "*.example.com." {
+		t.Errorf("recordName() = %q", got)
+	}
+	if got := hostedZoneId("/hostedzone/Z123"); got != "Z123" {
+		t.Errorf("hostedZoneId() = %q", got)
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	missing, unexpected := compareValues(
+		[]string{"10 Mail.example.com.", `"v=spf1 " "-all"`, "1.2.3.4"},
+		[]string{"10 mail.example.com", "v=spf1 -all", "5.6.7.8"},
+	)
+	if !reflect.DeepEqual(missing, []string{"1.2.3.4"}) {
+		t.Errorf("missing = %v", missing)
+	}
+	if !reflect.DeepEqual(unexpected, []string{"5.6.7.8"}) {
+		t.Errorf("unexpected = %v", unexpected)
+	}
+}
+
+func TestRecordSetKeepsValues(t *testing.T) {
+	record := recordSet(r53Types.ResourceRecordSet{
+		Name: aws.String("example.com."),
+		Type: r53Types.RRTypeTxt,
+		TTL:  aws.Int64(300),
+		ResourceRecords: []r53Types.ResourceRecord{
+			{Value: aws.String(`"v=spf1 include:a.example.com,include:b.example.com -all"`)},
+			{Value: aws.String(`"site-verification=abc"`)},
+		},
+	})
+	want := []string{`"v=spf1 include:a.example.com,include:b.example.com -all"`, `"site-verification=abc"`}
+	if !reflect.DeepEqual(record.Values, want) {
+		t.Errorf("Values = %v, want %v", record.Values, want)
+	}
+	missing, unexpected := compareValues(record.Values, []string{"v=spf1 include:a.example.com,include:b.example.com -all", "site-verification=abc"})
+	if len(missing) != 0 || len(unexpected) != 0 {
+		t.Errorf("compareValues() = %v, %v, want a match", missing, unexpected)
+	}
+}
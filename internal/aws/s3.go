@@ -2,18 +2,19 @@ package aws
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
-	"os"
-	"os/user"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
-	"github.com/atotto/clipboard"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/one2nc/cloudlens/internal/download"
 	"github.com/rs/zerolog/log"
 )
 
@@ -23,6 +24,9 @@ type BucketResp struct {
 	Region       string
 }
 
+// S3MaxPresignExpiry is the longest validity of a SigV4 presigned URL.
+const S3MaxPresignExpiry = 7 * 24 * time.Hour
+
 type Presigner struct {
 	PresignClient *s3.PresignClient
 }
@@ -63,50 +67,108 @@ func GetInfoAboutBucket(cfg aws.Config, bucketName string, delimiter string, pre
 	return result, nil
 }
 
-func GetPreSignedUrl(cfg aws.Config, bucketName, key string) string {
-	s3Serv := *s3.NewFromConfig(cfg)
-	input := &s3.GetObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(key),
+// GetPreSignedUrl signs a GET or PUT request on the given object, valid for expiry.
+func GetPreSignedUrl(cfg aws.Config, bucketName, key, method string, expiry time.Duration) (string, error) {
+	if expiry <= 0 || expiry > S3MaxPresignExpiry {
+		return "", fmt.Errorf("expiry must be between 1s and %v", S3MaxPresignExpiry)
 	}
-	psClient := s3.NewPresignClient(&s3Serv, s3.WithPresignExpires(15*time.Minute))
-	res, _ := psClient.PresignGetObject(context.Background(), input)
-	return res.URL
-}
-
-func DownloadObject(cfg aws.Config, bucketName, key string) string {
 	s3Serv := s3.NewFromConfig(cfg)
-	downloader := manager.NewDownloader(s3Serv)
-	usr, err := user.Current()
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("error in getting the machine's user: %v", err))
+	psClient := s3.NewPresignClient(s3Serv, s3.WithPresignExpires(expiry))
+	var (
+		res *v4.PresignedHTTPRequest
+		err error
+	)
+	switch method {
+	case http.MethodGet:
+		res, err = psClient.PresignGetObject(context.Background(), &s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		})
+	case http.MethodPut:
+		res, err = psClient.PresignPutObject(context.Background(), &s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		})
+	default:
+		return "", fmt.Errorf("unsupported presign method %q", method)
 	}
-	path := usr.HomeDir + "/cloudlens/s3objects/"
-	err = os.MkdirAll(path, os.ModePerm)
 	if err != nil {
-		log.Info().Msg(fmt.Sprintf("error in creating s3 Object directory: %v", err))
+		log.Info().Msg(fmt.Sprintf("Error in presigning %v %v/%v. err: %v", method, bucketName, key, err))
+		return "", err
+	}
+	return res.URL, nil
+}
+
+// S3Source lists and reads objects of a bucket for the downloader.
+type S3Source struct {
+	client     *s3.Client
+	bucketName string
+}
+
+// NewS3Source returns a download source for the given bucket.
+func NewS3Source(cfg aws.Config, bucketName string) *S3Source {
+	return &S3Source{client: s3.NewFromConfig(cfg), bucketName: bucketName}
+}
+
+// List returns all objects under prefix, recursively.
+func (s *S3Source) List(ctx context.Context, prefix string) ([]download.Object, error) {
+	var objs []download.Object
+	p := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: &s.bucketName,
+		Prefix: &prefix,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error in listing objects of %v/%v. err: %v", s.bucketName, prefix, err))
+			return nil, err
+		}
+		for _, o := range page.Contents {
+			key := aws.ToString(o.Key)
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+			objs = append(objs, download.Object{
+				Key:     key,
+				Size:    o.Size,
+				MD5:     etagMD5(aws.ToString(o.ETag)),
+				ModTime: aws.ToTime(o.LastModified),
+			})
+		}
+	}
+	return objs, nil
+}
+
+// Open returns a reader on the object starting at offset.
+func (s *S3Source) Open(ctx context.Context, o *download.Object, offset int64) (io.ReadCloser, error) {
+	input := &s3.GetObjectInput{
+		Bucket: &s.bucketName,
+		Key:    &o.Key,
 	}
-	files := strings.Split(key, "/")
-	objectName := files[len(files)-1]
-	p := fmt.Sprintf("%v%v", path, objectName)
-	log.Info().Msg(fmt.Sprintf("path: %v", p))
-	f, err := os.Create(p)
+	if offset > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	}
+	out, err := s.client.GetObject(ctx, input)
 	if err != nil {
-		log.Info().Msg(fmt.Sprintf("Failed to create file, err: %v", err))
+		return nil, err
+	}
+	// ETags of SSE-KMS/SSE-C encrypted objects are not the content md5.
+	if out.ServerSideEncryption == types.ServerSideEncryptionAwsKms || out.SSECustomerAlgorithm != nil {
+		o.MD5 = ""
+	}
+	return out.Body, nil
+}
+
+// etagMD5 returns the md5 carried by a single part upload ETag, if any.
+func etagMD5(etag string) string {
+	etag = strings.Trim(etag, `"`)
+	if len(etag) != 32 {
 		return ""
 	}
-	defer f.Close()
-	n, err := downloader.Download(context.Background(), f, &s3.GetObjectInput{
-		Bucket: &bucketName,
-		Key:    &key,
-	})
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("failed to download file, err: %v", err))
+	if _, err := hex.DecodeString(etag); err != nil {
 		return ""
 	}
-	clipboard.WriteAll(p)
-
-	return fmt.Sprintf("%v with size %d bytes, downloaded and its path copied to the clipboard", objectName, n)
+	return etag
 }
 
 func GetBuckEncryption(cfg aws.Config, bucketName string) *types.ServerSideEncryptionConfiguration {
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/rs/zerolog/log"
+)
+
+// ListSchedules returns the EventBridge Scheduler schedules of the region.
+func ListSchedules(cfg aws.Config) ([]ScheduleResp, error) {
+	client := scheduler.NewFromConfig(cfg)
+	var schedules []ScheduleResp
+	paginator := scheduler.NewListSchedulesPaginator(client, &scheduler.ListSchedulesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing schedules, err: %v", err))
+			return nil, err
+		}
+		for _, s := range output.Schedules {
+			schedule, err := GetSchedule(cfg, aws.ToString(s.GroupName), aws.ToString(s.Name))
+			if err != nil {
+				return nil, err
+			}
+			var target string
+			if s.Target != nil {
+				target = arnResource(aws.ToString(s.Target.Arn))
+			}
+			schedules = append(schedules, ScheduleResp{
+				Name:         aws.ToString(s.Name),
+				Group:        aws.ToString(s.GroupName),
+				State:        string(s.State),
+				Expression:   aws.ToString(schedule.ScheduleExpression),
+				Timezone:     aws.ToString(schedule.ScheduleExpressionTimezone),
+				Target:       target,
+				LastModified: ecsLocalTime(s.LastModificationDate),
+			})
+		}
+	}
+	return schedules, nil
+}
+
+// GetSchedule describes a schedule.
+func GetSchedule(cfg aws.Config, group, name string) (*scheduler.GetScheduleOutput, error) {
+	output, err := scheduler.NewFromConfig(cfg).GetSchedule(context.TODO(), &scheduler.GetScheduleInput{
+		GroupName: &group,
+		Name:      &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting schedule %v/%v, err: %v", group, name, err))
+		return nil, err
+	}
+	return output, nil
+}
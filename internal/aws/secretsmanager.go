@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultSecretsKmsKey is the key secrets are encrypted with when none is set.
+const DefaultSecretsKmsKey = "aws/secretsmanager"
+
+// ListSecrets returns the secrets of the region, values excluded.
+func ListSecrets(cfg aws.Config) ([]SecretResp, error) {
+	var secrets []SecretResp
+	paginator := secretsmanager.NewListSecretsPaginator(secretsmanager.NewFromConfig(cfg), &secretsmanager.ListSecretsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing secrets, err: %v", err))
+			return nil, err
+		}
+		for _, s := range output.SecretList {
+			kmsKey := aws.ToString(s.KmsKeyId)
+			if kmsKey == "" {
+				kmsKey = DefaultSecretsKmsKey
+			}
+			secrets = append(secrets, SecretResp{
+				Name:        aws.ToString(s.Name),
+				Rotation:    secretRotation(aws.ToBool(s.RotationEnabled), s.RotationRules),
+				LastChanged: ecsLocalTime(s.LastChangedDate),
+				KmsKey:      kmsKey,
+				Description: aws.ToString(s.Description),
+			})
+		}
+	}
+	return secrets, nil
+}
+
+func secretRotation(enabled bool, rules *smTypes.RotationRulesType) string {
+	if !enabled {
+		return "disabled"
+	}
+	switch {
+	case rules == nil:
+		return "enabled"
+	case rules.ScheduleExpression != nil:
+		return *rules.ScheduleExpression
+	case rules.AutomaticallyAfterDays != nil:
+		return fmt.Sprintf("every %d days", *rules.AutomaticallyAfterDays)
+	default:
+		return "enabled"
+	}
+}
+
+// GetSecret describes a secret, its value excluded.
+func GetSecret(cfg aws.Config, name string) (*secretsmanager.DescribeSecretOutput, error) {
+	output, err := secretsmanager.NewFromConfig(cfg).DescribeSecret(context.TODO(), &secretsmanager.DescribeSecretInput{
+		SecretId: &name,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing secret %v, err: %v", name, err))
+		return nil, err
+	}
+	return output, nil
+}
+
+// GetSecretValue returns the value of a secret version, the current one when
+// versionId is empty. Binary secrets are returned base64 encoded.
+// The value must never be logged.
+func GetSecretValue(cfg aws.Config, name, versionId string) (string, error) {
+	input := &secretsmanager.GetSecretValueInput{SecretId: &name}
+	if versionId != "" {
+		input.VersionId = &versionId
+	}
+	output, err := secretsmanager.NewFromConfig(cfg).GetSecretValue(context.TODO(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting value of secret %v, err: %v", name, err))
+		return "", err
+	}
+	if output.SecretString != nil {
+		return *output.SecretString, nil
+	}
+	return base64.StdEncoding.EncodeToString(output.SecretBinary), nil
+}
+
+// ListSecretVersions returns the versions of a secret, newest first.
+func ListSecretVersions(cfg aws.Config, name string) ([]SecretVersionResp, error) {
+	var versions []smTypes.SecretVersionsListEntry
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(secretsmanager.NewFromConfig(cfg), &secretsmanager.ListSecretVersionIdsInput{
+		SecretId:          &name,
+		IncludeDeprecated: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing versions of secret %v, err: %v", name, err))
+			return nil, err
+		}
+		versions = append(versions, output.Versions...)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return aws.ToTime(versions[i].CreatedDate).After(aws.ToTime(versions[j].CreatedDate))
+	})
+	resp := make([]SecretVersionResp, 0, len(versions))
+	for _, v := range versions {
+		resp = append(resp, SecretVersionResp{
+			VersionId:    aws.ToString(v.VersionId),
+			Stages:       strings.Join(v.VersionStages, ","),
+			CreatedAt:    ecsLocalTime(v.CreatedDate),
+			LastAccessed: ecsLocalTime(v.LastAccessedDate),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+func TestSecretRotation(t *testing.T) {
+	uu := map[string]struct {
+		enabled bool
+		rules   *smTypes.RotationRulesType
+		want    string
+	}{
+		"disabled": {want: "disabled"},
+		"no-rules": {enabled: true, want: "enabled"},
+		"days":     {enabled: true, rules: &smTypes.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)}, want: "every 30 days"},
+		"schedule": {enabled: true, rules: &smTypes.RotationRulesType{ScheduleExpression: aws.String("rate(10 days)")}, want: "rate(10 days)"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := secretRotation(u.enabled, u.rules); got != u.want {
+				t.Errorf("secretRotation() = %q, want %q", got, u.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/rs/zerolog/log"
+)
+
+// maxExecutions caps the number of recent executions listed per state machine.
+const maxExecutions = 100
+
+// ListStateMachines returns the state machines of the region.
+func ListStateMachines(cfg aws.Config) ([]StateMachineResp, error) {
+	var machines []StateMachineResp
+	paginator := sfn.NewListStateMachinesPaginator(sfn.NewFromConfig(cfg), &sfn.ListStateMachinesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing state machines, err: %v", err))
+			return nil, err
+		}
+		for _, m := range output.StateMachines {
+			machines = append(machines, StateMachineResp{
+				Name:    aws.ToString(m.Name),
+				Type:    string(m.Type),
+				Created: ecsLocalTime(m.CreationDate),
+				Arn:     aws.ToString(m.StateMachineArn),
+			})
+		}
+	}
+	return machines, nil
+}
+
+// GetStateMachineDefinition returns the indented ASL definition of a state machine.
+func GetStateMachineDefinition(cfg aws.Config, arn string) (string, error) {
+	output, err := sfn.NewFromConfig(cfg).DescribeStateMachine(context.TODO(), &sfn.DescribeStateMachineInput{
+		StateMachineArn: &arn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing state machine %v, err: %v", arn, err))
+		return "", err
+	}
+	return indentJSON(aws.ToString(output.Definition)), nil
+}
+
+func indentJSON(s string) string {
+	var b bytes.Buffer
+	if err := json.Indent(&b, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return b.String()
+}
+
+// ListExecutions returns the most recent executions of a state machine.
+func ListExecutions(cfg aws.Config, machineArn string) ([]ExecutionResp, error) {
+	output, err := sfn.NewFromConfig(cfg).ListExecutions(context.TODO(), &sfn.ListExecutionsInput{
+		StateMachineArn: &machineArn,
+		MaxResults:      maxExecutions,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error listing executions of %v, err: %v", machineArn, err))
+		return nil, err
+	}
+	now := time.Now()
+	executions := make([]ExecutionResp, 0, len(output.Executions))
+	for _, e := range output.Executions {
+		executions = append(executions, ExecutionResp{
+			Name:     aws.ToString(e.Name),
+			Status:   string(e.Status),
+			Started:  ecsLocalTime(e.StartDate),
+			Stopped:  ecsLocalTime(e.StopDate),
+			Duration: executionDuration(e.StartDate, e.StopDate, now),
+			Arn:      aws.ToString(e.ExecutionArn),
+		})
+	}
+	return executions, nil
+}
+
+// executionDuration returns how long an execution ran, up to now when it is
+// still running.
+func executionDuration(start, stop *time.Time, now time.Time) string {
+	if start == nil {
+		return ""
+	}
+	end := now
+	if stop != nil {
+		end = *stop
+	}
+	return end.Sub(*start).Round(time.Second).String()
+}
+
+// GetExecution describes an execution.
+func GetExecution(cfg aws.Config, arn string) (*sfn.DescribeExecutionOutput, error) {
+	output, err := sfn.NewFromConfig(cfg).DescribeExecution(context.TODO(), &sfn.DescribeExecutionInput{
+		ExecutionArn: &arn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing execution %v, err: %v", arn, err))
+		return nil, err
+	}
+	return output, nil
+}
+
+// StartExecution starts an execution of a state machine with a JSON input,
+// named by AWS when name is empty, and returns its arn.
+func StartExecution(cfg aws.Config, machineArn, name, input string) (string, error) {
+	if !json.Valid([]byte(input)) {
+		return "", fmt.Errorf("input is not valid JSON")
+	}
+	params := &sfn.StartExecutionInput{
+		StateMachineArn: &machineArn,
+		Input:           &input,
+	}
+	if name != "" {
+		params.Name = &name
+	}
+	output, err := sfn.NewFromConfig(cfg).StartExecution(context.TODO(), params)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error starting execution of %v, err: %v", machineArn, err))
+		return "", err
+	}
+	return aws.ToString(output.ExecutionArn), nil
+}
+
+// StopExecution stops a running execution.
+func StopExecution(cfg aws.Config, arn string) error {
+	_, err := sfn.NewFromConfig(cfg).StopExecution(context.TODO(), &sfn.StopExecutionInput{
+		ExecutionArn: &arn,
+		Cause:        aws.String("Stopped from cloudlens"),
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error stopping execution %v, err: %v", arn, err))
+	}
+	return err
+}
+
+func getExecutionHistory(cfg aws.Config, arn string) ([]sfnTypes.HistoryEvent, error) {
+	var events []sfnTypes.HistoryEvent
+	paginator := sfn.NewGetExecutionHistoryPaginator(sfn.NewFromConfig(cfg), &sfn.GetExecutionHistoryInput{
+		ExecutionArn: &arn,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting history of execution %v, err: %v", arn, err))
+			return nil, err
+		}
+		events = append(events, output.Events...)
+	}
+	return events, nil
+}
+
+// ListExecutionEvents returns the event history of an execution.
+func ListExecutionEvents(cfg aws.Config, arn string) ([]ExecutionEventResp, error) {
+	history, err := getExecutionHistory(cfg, arn)
+	if err != nil {
+		return nil, err
+	}
+	states := historyStates(history)
+	events := make([]ExecutionEventResp, 0, len(history))
+	for _, e := range history {
+		events = append(events, ExecutionEventResp{
+			Id:        strconv.FormatInt(e.Id, 10),
+			Timestamp: ecsLocalTime(e.Timestamp),
+			Type:      string(e.Type),
+			State:     states[e.Id],
+			Detail:    historyEventDetail(e),
+			Raw:       e,
+		})
+	}
+	return events, nil
+}
+
+// historyStates maps history event ids to the state they belong to, following
+// the previous event ids back to the entered state.
+func historyStates(history []sfnTypes.HistoryEvent) map[int64]string {
+	states := make(map[int64]string, len(history))
+	for _, e := range history {
+		switch {
+		case e.StateEnteredEventDetails != nil:
+			states[e.Id] = aws.ToString(e.StateEnteredEventDetails.Name)
+		case e.StateExitedEventDetails != nil:
+			states[e.Id] = aws.ToString(e.StateExitedEventDetails.Name)
+		default:
+			states[e.Id] = states[e.PreviousEventId]
+		}
+	}
+	return states
+}
+
+func historyEventDetail(e sfnTypes.HistoryEvent) string {
+	switch {
+	case e.ExecutionFailedEventDetails != nil:
+		return failureDetail(e.ExecutionFailedEventDetails.Error, e.ExecutionFailedEventDetails.Cause)
+	case e.ExecutionTimedOutEventDetails != nil:
+		return failureDetail(e.ExecutionTimedOutEventDetails.Error, e.ExecutionTimedOutEventDetails.Cause)
+	case e.ExecutionAbortedEventDetails != nil:
+		return failureDetail(e.ExecutionAbortedEventDetails.Error, e.ExecutionAbortedEventDetails.Cause)
+	case e.TaskFailedEventDetails != nil:
+		return failureDetail(e.TaskFailedEventDetails.Error, e.TaskFailedEventDetails.Cause)
+	case e.TaskTimedOutEventDetails != nil:
+		return failureDetail(e.TaskTimedOutEventDetails.Error, e.TaskTimedOutEventDetails.Cause)
+	case e.TaskStartFailedEventDetails != nil:
+		return failureDetail(e.TaskStartFailedEventDetails.Error, e.TaskStartFailedEventDetails.Cause)
+	case e.TaskSubmitFailedEventDetails != nil:
+		return failureDetail(e.TaskSubmitFailedEventDetails.Error, e.TaskSubmitFailedEventDetails.Cause)
+	case e.LambdaFunctionFailedEventDetails != nil:
+		return failureDetail(e.LambdaFunctionFailedEventDetails.Error, e.LambdaFunctionFailedEventDetails.Cause)
+	case e.LambdaFunctionTimedOutEventDetails != nil:
+		return failureDetail(e.LambdaFunctionTimedOutEventDetails.Error, e.LambdaFunctionTimedOutEventDetails.Cause)
+	case e.ActivityFailedEventDetails != nil:
+		return failureDetail(e.ActivityFailedEventDetails.Error, e.ActivityFailedEventDetails.Cause)
+	case e.ActivityTimedOutEventDetails != nil:
+		return failureDetail(e.ActivityTimedOutEventDetails.Error, e.ActivityTimedOutEventDetails.Cause)
+	case e.MapRunFailedEventDetails != nil:
+		return failureDetail(e.MapRunFailedEventDetails.Error, e.MapRunFailedEventDetails.Cause)
+	case e.TaskScheduledEventDetails != nil:
+		return aws.ToString(e.TaskScheduledEventDetails.ResourceType) + ":" + aws.ToString(e.TaskScheduledEventDetails.Resource)
+	case e.LambdaFunctionScheduledEventDetails != nil:
+		return arnResourceName(aws.ToString(e.LambdaFunctionScheduledEventDetails.Resource))
+	}
+	return ""
+}
+
+func failureDetail(errCode, cause *string) string {
+	if cause == nil {
+		return aws.ToString(errCode)
+	}
+	return aws.ToString(errCode) + ": " + compactJSON(*cause)
+}
+
+// GetExecutionPath returns the definition of the state machine of an
+// execution along with the states it went through and the one it failed in,
+// if any.
+func GetExecutionPath(cfg aws.Config, arn string) (*ExecutionPathResp, error) {
+	output, err := sfn.NewFromConfig(cfg).DescribeStateMachineForExecution(context.TODO(), &sfn.DescribeStateMachineForExecutionInput{
+		ExecutionArn: &arn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing state machine of execution %v, err: %v", arn, err))
+		return nil, err
+	}
+	history, err := getExecutionHistory(cfg, arn)
+	if err != nil {
+		return nil, err
+	}
+	visited, failed := executionPath(history)
+	return &ExecutionPathResp{
+		Definition: aws.ToString(output.Definition),
+		Visited:    visited,
+		Failed:     failed,
+	}, nil
+}
+
+// executionPath returns the states entered by an execution and, when the
+// execution did not succeed, the last state it entered without exiting it.
+func executionPath(history []sfnTypes.HistoryEvent) (map[string]bool, string) {
+	visited := make(map[string]bool)
+	var open []string
+	var failed bool
+	for _, e := range history {
+		switch e.Type {
+		case sfnTypes.HistoryEventTypeExecutionFailed, sfnTypes.HistoryEventTypeExecutionTimedOut, sfnTypes.HistoryEventTypeExecutionAborted:
+			failed = true
+		}
+		switch {
+		case e.StateEnteredEventDetails != nil:
+			name := aws.ToString(e.StateEnteredEventDetails.Name)
+			visited[name] = true
+			open = append(open, name)
+		case e.StateExitedEventDetails != nil:
+			name := aws.ToString(e.StateExitedEventDetails.Name)
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == name {
+					open = append(open[:i], open[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	if !failed || len(open) == 0 {
+		return visited, ""
+	}
+	return visited, open[len(open)-1]
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+func TestExecutionDuration(t *testing.T) {
+	start := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+	stop := start.Add(90*time.Second + 400*time.Millisecond)
+	now := start.Add(time.Hour)
+
+	if got := executionDuration(&start, &stop, now); got != "1m30s" {
+		t.Errorf("executionDuration() = %v, want 1m30s", got)
+	}
+	if got := executionDuration(&start, nil, now); got != "1h0m0s" {
+		t.Errorf("executionDuration() = %v, want 1h0m0s", got)
+	}
+	if got := executionDuration(nil, nil, now); got != "" {
+		t.Errorf("executionDuration() = %v, want none", got)
+	}
+}
+
+func failedHistory() []sfnTypes.HistoryEvent {
+	return []sfnTypes.HistoryEvent{
+		{Id: 1, Type: sfnTypes.HistoryEventTypeExecutionStarted},
+		{Id: 2, PreviousEventId: 1, Type: sfnTypes.HistoryEventTypeTaskStateEntered, StateEnteredEventDetails: &sfnTypes.StateEnteredEventDetails{Name: aws.String("Validate")}},
+		{Id: 3, PreviousEventId: 2, Type: sfnTypes.HistoryEventTypeTaskStateExited, StateExitedEventDetails: &sfnTypes.StateExitedEventDetails{Name: aws.String("Validate")}},
+		{Id: 4, PreviousEventId: 3, Type: sfnTypes.HistoryEventTypeTaskStateEntered, StateEnteredEventDetails: &sfnTypes.StateEnteredEventDetails{Name: aws.String("Charge")}},
+		{Id: 5, PreviousEventId: 4, Type: sfnTypes.HistoryEventTypeTaskFailed, TaskFailedEventDetails: &sfnTypes.TaskFailedEventDetails{Error: aws.String("PaymentError"), Cause: aws.String(`{ "code": 402 }`)}},
+		{Id: 6, PreviousEventId: 5, Type: sfnTypes.HistoryEventTypeExecutionFailed, ExecutionFailedEventDetails: &sfnTypes.ExecutionFailedEventDetails{Error: aws.String("PaymentError")}},
+	}
+}
+
+func TestHistoryStates(t *testing.T) {
+	want := map[int64]string{1: "", 2: "Validate", 3: "Validate", 4: "Charge", 5: "Charge", 6: "Charge"}
+	if got := historyStates(failedHistory()); !reflect.DeepEqual(got, want) {
+		t.Errorf("historyStates() = %v, want %v", got, want)
+	}
+}
+
+func TestHistoryEventDetail(t *testing.T) {
+	history := failedHistory()
+	if got := historyEventDetail(history[4]); got != `PaymentError: {"code":402}` {
+		t.Errorf("historyEventDetail() = %v", got)
+	}
+	if got := historyEventDetail(history[5]); got != "PaymentError" {
+		t.Errorf("historyEventDetail() = %v", got)
+	}
+	if got := historyEventDetail(history[0]); got != "" {
+		t.Errorf("historyEventDetail() = %v, want none", got)
+	}
+}
+
+func TestExecutionPath(t *testing.T) {
+	history := failedHistory()
+
+	visited, failed := executionPath(history)
+	if !reflect.DeepEqual(visited, map[string]bool{"Validate": true, "Charge": true}) || failed != "Charge" {
+		t.Errorf("executionPath() = %v, %v", visited, failed)
+	}
+
+	_, failed = executionPath(history[:5])
+	if failed != "" {
+		t.Errorf("executionPath() of a running execution failed in %v", failed)
+	}
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// SessionManagerPlugin is the binary attaching the terminal to SSM and ECS exec sessions.
+	SessionManagerPlugin = "session-manager-plugin"
+
+	// DefaultShellCommand is the command run in containers by ECS exec.
+	DefaultShellCommand = "/bin/sh"
+
+	sessionManagerPluginDocs = "https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html"
+)
+
+// ErrSessionManagerPluginMissing is returned when the session manager plugin is not installed.
+var ErrSessionManagerPluginMissing = fmt.Errorf("%s not found in PATH, it is required for ECS exec and SSM sessions, see %s", SessionManagerPlugin, sessionManagerPluginDocs)
+
+// ShellCommand represents an interactive command to attach to a session.
+type ShellCommand struct {
+	Name string
+	Args []string
+}
+
+// sessionManagerPluginPath locates the session manager plugin.
+func sessionManagerPluginPath() (string, error) {
+	path, err := exec.LookPath(SessionManagerPlugin)
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", ErrSessionManagerPluginMissing
+		}
+		return "", err
+	}
+	return path, nil
+}
+
+// EcsExecCommand starts an ECS exec session in a container and returns the
+// command attaching the terminal to it.
+func EcsExecCommand(cfg aws.Config, profile, clusterName, taskId, containerName, runtimeId, command string) (*ShellCommand, error) {
+	plugin, err := sessionManagerPluginPath()
+	if err != nil {
+		return nil, err
+	}
+	ecsClient := ecs.NewFromConfig(cfg)
+	output, err := ecsClient.ExecuteCommand(context.TODO(), &ecs.ExecuteCommandInput{
+		Cluster:     &clusterName,
+		Task:        &taskId,
+		Container:   &containerName,
+		Command:     &command,
+		Interactive: true,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error executing command in container %v of task %v, err: %v", containerName, taskId, err))
+		return nil, err
+	}
+	endpoint, err := serviceEndpoint(cfg, ecs.ServiceID, func(region string) (aws.Endpoint, error) {
+		return ecs.NewDefaultEndpointResolver().ResolveEndpoint(region, ecs.EndpointResolverOptions{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	target := fmt.Sprintf("ecs:%s_%s_%s", clusterName, taskId, runtimeId)
+	return pluginCommand(plugin, output.Session, cfg.Region, profile, target, endpoint)
+}
+
+// SSMSessionCommand starts an SSM session on an instance and returns the
+// command attaching the terminal to it.
+func SSMSessionCommand(cfg aws.Config, profile, instanceId string) (*ShellCommand, error) {
+	plugin, err := sessionManagerPluginPath()
+	if err != nil {
+		return nil, err
+	}
+	ssmClient := ssm.NewFromConfig(cfg)
+	output, err := ssmClient.StartSession(context.TODO(), &ssm.StartSessionInput{
+		Target: &instanceId,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error starting SSM session on instance %v, err: %v", instanceId, err))
+		return nil, err
+	}
+	session := map[string]*string{
+		"SessionId":  output.SessionId,
+		"StreamUrl":  output.StreamUrl,
+		"TokenValue": output.TokenValue,
+	}
+	endpoint, err := serviceEndpoint(cfg, ssm.ServiceID, func(region string) (aws.Endpoint, error) {
+		return ssm.NewDefaultEndpointResolver().ResolveEndpoint(region, ssm.EndpointResolverOptions{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pluginCommand(plugin, session, cfg.Region, profile, instanceId, endpoint)
+}
+
+// pluginCommand builds the session manager plugin invocation the aws cli uses.
+func pluginCommand(plugin string, session interface{}, region, profile, target, endpoint string) (*ShellCommand, error) {
+	s, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+	params, err := json.Marshal(map[string]string{"Target": target})
+	if err != nil {
+		return nil, err
+	}
+	return &ShellCommand{
+		Name: plugin,
+		Args: []string{string(s), region, "StartSession", profile, string(params), endpoint},
+	}, nil
+}
+
+// serviceEndpoint returns the endpoint the session manager plugin talks to,
+// preferring the endpoint resolver of the config (e.g. localstack) over the
+// SDK default, which knows the partition of the region.
+func serviceEndpoint(cfg aws.Config, serviceID string, defaultResolver func(region string) (aws.Endpoint, error)) (string, error) {
+	if cfg.EndpointResolverWithOptions != nil {
+		if ep, err := cfg.EndpointResolverWithOptions.ResolveEndpoint(serviceID, cfg.Region); err == nil {
+			return ep.URL, nil
+		}
+	}
+	if cfg.EndpointResolver != nil {
+		if ep, err := cfg.EndpointResolver.ResolveEndpoint(serviceID, cfg.Region); err == nil {
+			return ep.URL, nil
+		}
+	}
+	ep, err := defaultResolver(cfg.Region)
+	if err != nil {
+		return "", err
+	}
+	return ep.URL, nil
+}
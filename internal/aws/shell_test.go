@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+func TestPluginCommand(t *testing.T) {
+	session := map[string]string{"SessionId": "s-1"}
+
+	cmd, err := pluginCommand("session-manager-plugin", session, "us-east-1", "dev", "ecs:c_t_r", "https://ecs.us-east-1.amazonaws.com")
+	if err != nil {
+		t.Fatalf("pluginCommand() err = %v", err)
+	}
+	want := []string{`{"SessionId":"s-1"}`, "us-east-1", "StartSession", "dev", `{"Target":"ecs:c_t_r"}`, "https://ecs.us-east-1.amazonaws.com"}
+	if len(cmd.Args) != len(want) {
+		t.Fatalf("pluginCommand() args = %v, want %v", cmd.Args, want)
+	}
+	for i := range want {
+		if cmd.Args[i] != want[i] {
+			t.Errorf("pluginCommand() arg %d = %v, want %v", i, cmd.Args[i], want[i])
+		}
+	}
+}
+
+func TestServiceEndpoint(t *testing.T) {
+	ecsResolver := func(region string) (aws.Endpoint, error) {
+		return ecs.NewDefaultEndpointResolver().ResolveEndpoint(region, ecs.EndpointResolverOptions{})
+	}
+	ssmResolver := func(region string) (aws.Endpoint, error) {
+		return ssm.NewDefaultEndpointResolver().ResolveEndpoint(region, ssm.EndpointResolverOptions{})
+	}
+	localstack := aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
+		return aws.Endpoint{URL: "http://localhost:4566", SigningRegion: region}, nil
+	})
+
+	uu := map[string]struct {
+		cfg       aws.Config
+		serviceID string
+		resolver  func(string) (aws.Endpoint, error)
+		endpoint  string
+	}{
+		"aws":        {cfg: aws.Config{Region: "us-east-1"}, serviceID: ecs.ServiceID, resolver: ecsResolver, endpoint: "https://ecs.us-east-1.amazonaws.com"},
+		"china":      {cfg: aws.Config{Region: "cn-north-1"}, serviceID: ecs.ServiceID, resolver: ecsResolver, endpoint: "https://ecs.cn-north-1.amazonaws.com.cn"},
+		"govcloud":   {cfg: aws.Config{Region: "us-gov-west-1"}, serviceID: ssm.ServiceID, resolver: ssmResolver, endpoint: "https://ssm.us-gov-west-1.amazonaws.com"},
+		"localstack": {cfg: aws.Config{Region: "us-east-1", EndpointResolver: localstack}, serviceID: ssm.ServiceID, resolver: ssmResolver, endpoint: "http://localhost:4566"},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			endpoint, err := serviceEndpoint(u.cfg, u.serviceID, u.resolver)
+			if err != nil {
+				t.Fatalf("serviceEndpoint() err = %v", err)
+			}
+			if endpoint != u.endpoint {
+				t.Errorf("serviceEndpoint() = %v, want %v", endpoint, u.endpoint)
+			}
+		})
+	}
+}
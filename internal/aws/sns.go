@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/rs/zerolog/log"
+)
+
+// SnsPendingConfirmation is the subscription arn of unconfirmed subscriptions.
+const SnsPendingConfirmation = "PendingConfirmation"
+
+// ListTopics returns the SNS topics of the region. When endpoint isn't empty,
+// only the topics with a subscription delivering to it are returned.
+func ListTopics(cfg aws.Config, endpoint string) ([]TopicResp, error) {
+	client := sns.NewFromConfig(cfg)
+	var arns []string
+	if endpoint != "" {
+		subs, err := listSubscriptions(client, "")
+		if err != nil {
+			return nil, err
+		}
+		arns = topicsFeeding(subs, endpoint)
+	} else {
+		paginator := sns.NewListTopicsPaginator(client, &sns.ListTopicsInput{})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(context.TODO())
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error listing topics, err: %v", err))
+				return nil, err
+			}
+			for _, t := range output.Topics {
+				arns = append(arns, aws.ToString(t.TopicArn))
+			}
+		}
+	}
+	topics := make([]TopicResp, 0, len(arns))
+	for _, arn := range arns {
+		attrs, err := GetTopicAttributes(cfg, arn)
+		if err != nil {
+			return nil, err
+		}
+		kind := "Standard"
+		if attrs["FifoTopic"] == "true" {
+			kind = "FIFO"
+		}
+		topics = append(topics, TopicResp{
+			Name:          arnResourceName(arn),
+			Arn:           arn,
+			Type:          kind,
+			DisplayName:   attrs["DisplayName"],
+			Subscriptions: attrs["SubscriptionsConfirmed"],
+			Pending:       attrs["SubscriptionsPending"],
+		})
+	}
+	return topics, nil
+}
+
+// topicsFeeding returns the sorted arns of the topics subscribed by endpoint.
+func topicsFeeding(subs []snsTypes.Subscription, endpoint string) []string {
+	seen := make(map[string]bool)
+	var arns []string
+	for _, s := range subs {
+		arn := aws.ToString(s.TopicArn)
+		if aws.ToString(s.Endpoint) != endpoint || seen[arn] {
+			continue
+		}
+		seen[arn] = true
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// arnResourceName returns the last colon separated segment of an arn.
+func arnResourceName(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// GetTopicAttributes returns the attributes of a topic.
+func GetTopicAttributes(cfg aws.Config, topicArn string) (map[string]string, error) {
+	output, err := sns.NewFromConfig(cfg).GetTopicAttributes(context.TODO(), &sns.GetTopicAttributesInput{
+		TopicArn: &topicArn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting attributes of topic %v, err: %v", topicArn, err))
+		return nil, err
+	}
+	return output.Attributes, nil
+}
+
+func listSubscriptions(client *sns.Client, topicArn string) ([]snsTypes.Subscription, error) {
+	var subs []snsTypes.Subscription
+	if topicArn == "" {
+		paginator := sns.NewListSubscriptionsPaginator(client, &sns.ListSubscriptionsInput{})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(context.TODO())
+			if err != nil {
+				log.Info().Msg(fmt.Sprintf("Error listing subscriptions, err: %v", err))
+				return nil, err
+			}
+			subs = append(subs, output.Subscriptions...)
+		}
+		return subs, nil
+	}
+	paginator := sns.NewListSubscriptionsByTopicPaginator(client, &sns.ListSubscriptionsByTopicInput{TopicArn: &topicArn})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error listing subscriptions of topic %v, err: %v", topicArn, err))
+			return nil, err
+		}
+		subs = append(subs, output.Subscriptions...)
+	}
+	return subs, nil
+}
+
+// ListSubscriptions returns the subscriptions of a topic with their filter policy.
+func ListSubscriptions(cfg aws.Config, topicArn string) ([]SubscriptionResp, error) {
+	client := sns.NewFromConfig(cfg)
+	subs, err := listSubscriptions(client, topicArn)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]SubscriptionResp, 0, len(subs))
+	for _, s := range subs {
+		arn := aws.ToString(s.SubscriptionArn)
+		sub := SubscriptionResp{
+			Id:       arnResourceName(arn),
+			Protocol: aws.ToString(s.Protocol),
+			Endpoint: aws.ToString(s.Endpoint),
+			Pending:  "false",
+			Arn:      arn,
+		}
+		if arn == SnsPendingConfirmation {
+			sub.Pending = "true"
+			resp = append(resp, sub)
+			continue
+		}
+		attrs, err := GetSubscriptionAttributes(cfg, arn)
+		if err != nil {
+			return nil, err
+		}
+		if attrs["PendingConfirmation"] == "true" {
+			sub.Pending = "true"
+		}
+		sub.FilterPolicy = compactJSON(attrs["FilterPolicy"])
+		resp = append(resp, sub)
+	}
+	return resp, nil
+}
+
+// compactJSON returns a JSON document on a single line, as is when invalid.
+func compactJSON(s string) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		return s
+	}
+	return b.String()
+}
+
+// GetSubscriptionAttributes returns the attributes of a subscription.
+func GetSubscriptionAttributes(cfg aws.Config, subscriptionArn string) (map[string]string, error) {
+	output, err := sns.NewFromConfig(cfg).GetSubscriptionAttributes(context.TODO(), &sns.GetSubscriptionAttributesInput{
+		SubscriptionArn: &subscriptionArn,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting attributes of subscription %v, err: %v", subscriptionArn, err))
+		return nil, err
+	}
+	return output.Attributes, nil
+}
+
+// IsFifoTopic returns true if the topic is a FIFO one.
+func IsFifoTopic(topicArn string) bool {
+	return strings.HasSuffix(topicArn, ".fifo")
+}
+
+// ParseMessageAttributes parses comma separated key=value pairs into string
+// message attributes.
+func ParseMessageAttributes(s string) (map[string]snsTypes.MessageAttributeValue, error) {
+	attrs := make(map[string]snsTypes.MessageAttributeValue)
+	for _, kv := range strings.Split(s, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid attribute %q, expected key=value", strings.TrimSpace(kv))
+		}
+		attrs[k] = snsTypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(strings.TrimSpace(v)),
+		}
+	}
+	return attrs, nil
+}
+
+// Publish publishes a message to a topic, groupId and dedupId only apply to
+// FIFO topics.
+func Publish(cfg aws.Config, topicArn, subject, message, attributes, groupId, dedupId string) (string, error) {
+	attrs, err := ParseMessageAttributes(attributes)
+	if err != nil {
+		return "", err
+	}
+	input := &sns.PublishInput{
+		TopicArn:          &topicArn,
+		Message:           &message,
+		MessageAttributes: attrs,
+	}
+	if subject != "" {
+		input.Subject = &subject
+	}
+	if IsFifoTopic(topicArn) {
+		if groupId == "" {
+			return "", fmt.Errorf("a message group id is required for FIFO topics")
+		}
+		input.MessageGroupId = &groupId
+		if dedupId != "" {
+			input.MessageDeduplicationId = &dedupId
+		}
+	}
+	output, err := sns.NewFromConfig(cfg).Publish(context.TODO(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error publishing to topic %v, err: %v", topicArn, err))
+		return "", err
+	}
+	return aws.ToString(output.MessageId), nil
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+func TestTopicsFeeding(t *testing.T) {
+	queue := "arn:aws:sqs:us-east-1:123:orders"
+	subs := []snsTypes.Subscription{
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:shipping"), Endpoint: aws.String(queue)},
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:billing"), Endpoint: aws.String(queue)},
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:billing"), Endpoint: aws.String(queue)},
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:alerts"), Endpoint: aws.String("ops@example.com")},
+	}
+
+	want := []string{"arn:aws:sns:us-east-1:123:billing", "arn:aws:sns:us-east-1:123:shipping"}
+	if got := topicsFeeding(subs, queue); !reflect.DeepEqual(got, want) {
+		t.Errorf("topicsFeeding() = %v, want %v", got, want)
+	}
+	if got := topicsFeeding(subs, "arn:aws:sqs:us-east-1:123:other"); got != nil {
+		t.Errorf("topicsFeeding() = %v, want none", got)
+	}
+}
+
+func TestParseMessageAttributes(t *testing.T) {
+	attrs, err := ParseMessageAttributes(" env = test, source=cloudlens ,")
+	if err != nil {
+		t.Fatalf("ParseMessageAttributes() err = %v", err)
+	}
+	if len(attrs) != 2 || aws.ToString(attrs["env"].StringValue) != "test" || aws.ToString(attrs["source"].DataType) != "String" {
+		t.Errorf("ParseMessageAttributes() = %v", attrs)
+	}
+
+	if attrs, err := ParseMessageAttributes(""); err != nil || len(attrs) != 0 {
+		t.Errorf("ParseMessageAttributes(\"\") = %v, %v", attrs, err)
+	}
+	if _, err := ParseMessageAttributes("env"); err == nil {
+		t.Errorf("ParseMessageAttributes(\"env\") expected an error")
+	}
+}
+
+func TestCompactJSON(t *testing.T) {
+	if got := compactJSON("{\n  \"event\": [\"created\"]\n}"); got != `{"event":["created"]}` {
+		t.Errorf("compactJSON() = %q", got)
+	}
+	if got := compactJSON(""); got != "" {
+		t.Errorf("compactJSON(\"\") = %q", got)
+	}
+}
@@ -14,6 +14,16 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// sqsMaxMessages is the max number of messages SQS returns per receive/batch call.
+	sqsMaxMessages = 10
+	// sqsRedriveVisibility hides messages being redriven from other consumers.
+	sqsRedriveVisibility = 30
+	// sqsRedriveWaitSeconds long polls the dead letter queue, as a short poll
+	// may come back empty while messages are left.
+	sqsRedriveWaitSeconds = 5
+)
+
 func GetAllQueues(cfg awsV2.Config) ([]SQSResp, error) {
 	queueResp := []SQSResp{}
 	sqsServ := *sqs.NewFromConfig(cfg)
@@ -57,15 +67,237 @@ func GetAllQueues(cfg awsV2.Config) ([]SQSResp, error) {
 	return queueResp, nil
 }
 
-func GetMessageFromQueue(cfg awsV2.Config, queueUrl string) (*sqs.ReceiveMessageOutput, error) {
-	sqsServ := *sqs.NewFromConfig(cfg)
-	result, err := sqsServ.ReceiveMessage(context.Background(), &sqs.ReceiveMessageInput{
-		QueueUrl:            &queueUrl,
-		MaxNumberOfMessages: (100),
+// GetQueueAttributes returns all attributes of a queue.
+func GetQueueAttributes(cfg awsV2.Config, queueUrl string) (map[string]string, error) {
+	sqsServ := sqs.NewFromConfig(cfg)
+	res, err := sqsServ.GetQueueAttributes(context.Background(), &sqs.GetQueueAttributesInput{
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameAll},
+		QueueUrl:       &queueUrl,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error in fetching queue attributes: %v", err))
+		return nil, err
+	}
+	return res.Attributes, nil
+}
+
+// sqsAttributesAPI is the part of the SQS client used to read queue attributes.
+type sqsAttributesAPI interface {
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+}
+
+// QueueArn returns the arn of a queue given its url.
+func QueueArn(cfg awsV2.Config, queueUrl string) (string, error) {
+	return queueArn(context.Background(), sqs.NewFromConfig(cfg), queueUrl)
+}
+
+func queueArn(ctx context.Context, api sqsAttributesAPI, queueUrl string) (string, error) {
+	res, err := api.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameQueueArn},
+		QueueUrl:       &queueUrl,
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error in fetching arn of queue %v: %v", queueUrl, err))
+		return "", err
+	}
+	arn, ok := res.Attributes[string(types.QueueAttributeNameQueueArn)]
+	if !ok || arn == "" {
+		return "", fmt.Errorf("no arn found for queue %s", queueUrl)
+	}
+	return arn, nil
+}
+
+// sqsPeekAPI is the part of the SQS client used to peek at messages.
+type sqsPeekAPI interface {
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)
+}
+
+// PeekMessages receives messages once and makes them visible again right away
+// so they stay available to consumers. Note each peek still bumps the receive
+// count, so it is only done on demand.
+func PeekMessages(cfg awsV2.Config, queueUrl string) ([]SQSMessageResp, error) {
+	return peekMessages(context.Background(), sqs.NewFromConfig(cfg), queueUrl)
+}
+
+func peekMessages(ctx context.Context, api sqsPeekAPI, queueUrl string) ([]SQSMessageResp, error) {
+	// A zero VisibilityTimeout is not sent by the SDK so the queue default
+	// applies, hence the visibility reset below.
+	result, err := api.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:              &queueUrl,
+		MaxNumberOfMessages:   sqsMaxMessages,
+		AttributeNames:        []types.QueueAttributeName{types.QueueAttributeNameAll},
+		MessageAttributeNames: []string{"All"},
 	})
 	if err != nil {
-		log.Info().Msg(fmt.Sprintf("Error in fetching queue attributes : %v", err))
+		log.Info().Msg(fmt.Sprintf("Error in receiving messages from %v: %v", queueUrl, err))
 		return nil, err
 	}
-	return result, nil
+	if err := resetVisibility(ctx, api, queueUrl, result.Messages); err != nil {
+		return nil, err
+	}
+	msgs := make([]SQSMessageResp, 0, len(result.Messages))
+	for _, m := range result.Messages {
+		msgs = append(msgs, toSQSMessageResp(m))
+	}
+	return msgs, nil
+}
+
+// resetVisibility makes received messages visible again to other consumers.
+// ChangeMessageVisibility is used as the batch call drops zero timeouts.
+func resetVisibility(ctx context.Context, api sqsPeekAPI, queueUrl string, messages []types.Message) error {
+	for _, m := range messages {
+		_, err := api.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+			QueueUrl:          &queueUrl,
+			ReceiptHandle:     m.ReceiptHandle,
+			VisibilityTimeout: 0,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error in resetting visibility of message %v from %v: %v", awsV2.ToString(m.MessageId), queueUrl, err))
+			return err
+		}
+	}
+	return nil
+}
+
+func toSQSMessageResp(m types.Message) SQSMessageResp {
+	msg := SQSMessageResp{
+		MessageId:         awsV2.ToString(m.MessageId),
+		ReceiptHandle:     awsV2.ToString(m.ReceiptHandle),
+		Body:              awsV2.ToString(m.Body),
+		ReceiveCount:      m.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)],
+		GroupId:           m.Attributes[string(types.MessageSystemAttributeNameMessageGroupId)],
+		Attributes:        m.Attributes,
+		MessageAttributes: m.MessageAttributes,
+	}
+	if ts, err := strconv.ParseInt(m.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)], 10, 64); err == nil {
+		localZone, _ := config.GetLocalTimeZone()
+		loc, _ := time.LoadLocation(localZone)
+		msg.Sent = time.UnixMilli(ts).In(loc).Format("Mon Jan _2 15:04:05 2006")
+	}
+	return msg
+}
+
+// IsFifoQueue checks if a queue url refers to a FIFO queue.
+func IsFifoQueue(queueUrl string) bool {
+	return strings.HasSuffix(queueUrl, ".fifo")
+}
+
+// SendMessage sends a message to a queue, groupId and dedupId only apply to FIFO queues.
+func SendMessage(cfg awsV2.Config, queueUrl, body, groupId, dedupId string) (string, error) {
+	sqsServ := sqs.NewFromConfig(cfg)
+	input := &sqs.SendMessageInput{
+		QueueUrl:    &queueUrl,
+		MessageBody: &body,
+	}
+	if IsFifoQueue(queueUrl) {
+		if groupId == "" {
+			return "", fmt.Errorf("a message group id is required for FIFO queues")
+		}
+		input.MessageGroupId = &groupId
+		if dedupId != "" {
+			input.MessageDeduplicationId = &dedupId
+		}
+	}
+	res, err := sqsServ.SendMessage(context.Background(), input)
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error in sending message to %v: %v", queueUrl, err))
+		return "", err
+	}
+	return awsV2.ToString(res.MessageId), nil
+}
+
+// DeleteMessages deletes messages given their receipt handles.
+func DeleteMessages(cfg awsV2.Config, queueUrl string, receiptHandles []string) error {
+	sqsServ := sqs.NewFromConfig(cfg)
+	for start := 0; start < len(receiptHandles); start += sqsMaxMessages {
+		end := start + sqsMaxMessages
+		if end > len(receiptHandles) {
+			end = len(receiptHandles)
+		}
+		entries := make([]types.DeleteMessageBatchRequestEntry, 0, end-start)
+		for i, h := range receiptHandles[start:end] {
+			entries = append(entries, types.DeleteMessageBatchRequestEntry{
+				Id:            awsV2.String(strconv.Itoa(start + i)),
+				ReceiptHandle: awsV2.String(h),
+			})
+		}
+		res, err := sqsServ.DeleteMessageBatch(context.Background(), &sqs.DeleteMessageBatchInput{
+			QueueUrl: &queueUrl,
+			Entries:  entries,
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error in deleting messages from %v: %v", queueUrl, err))
+			return err
+		}
+		if len(res.Failed) > 0 {
+			return fmt.Errorf("failed to delete %d messages: %s", len(res.Failed), awsV2.ToString(res.Failed[0].Message))
+		}
+	}
+	return nil
+}
+
+// PurgeQueue deletes all messages of a queue.
+func PurgeQueue(cfg awsV2.Config, queueUrl string) error {
+	sqsServ := sqs.NewFromConfig(cfg)
+	_, err := sqsServ.PurgeQueue(context.Background(), &sqs.PurgeQueueInput{QueueUrl: &queueUrl})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error in purging queue %v: %v", queueUrl, err))
+	}
+	return err
+}
+
+// RedriveMessages moves all messages of a dead letter queue back to its source queue.
+func RedriveMessages(cfg awsV2.Config, dlqUrl string) (int, error) {
+	sqsServ := sqs.NewFromConfig(cfg)
+	src, err := sqsServ.ListDeadLetterSourceQueues(context.Background(), &sqs.ListDeadLetterSourceQueuesInput{QueueUrl: &dlqUrl})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error in listing source queues of %v: %v", dlqUrl, err))
+		return 0, err
+	}
+	if len(src.QueueUrls) != 1 {
+		return 0, fmt.Errorf("expected %v to be the dead letter queue of a single queue, but found %d", dlqUrl, len(src.QueueUrls))
+	}
+	srcUrl := src.QueueUrls[0]
+
+	var moved int
+	for {
+		res, err := sqsServ.ReceiveMessage(context.Background(), &sqs.ReceiveMessageInput{
+			QueueUrl:              &dlqUrl,
+			MaxNumberOfMessages:   sqsMaxMessages,
+			VisibilityTimeout:     sqsRedriveVisibility,
+			WaitTimeSeconds:       sqsRedriveWaitSeconds,
+			AttributeNames:        []types.QueueAttributeName{types.QueueAttributeNameAll},
+			MessageAttributeNames: []string{"All"},
+		})
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error in receiving messages from %v: %v", dlqUrl, err))
+			return moved, err
+		}
+		if len(res.Messages) == 0 {
+			return moved, nil
+		}
+		for _, m := range res.Messages {
+			input := &sqs.SendMessageInput{
+				QueueUrl:          &srcUrl,
+				MessageBody:       m.Body,
+				MessageAttributes: m.MessageAttributes,
+			}
+			if IsFifoQueue(srcUrl) {
+				input.MessageGroupId = awsV2.String(m.Attributes[string(types.MessageSystemAttributeNameMessageGroupId)])
+				if id := m.Attributes[string(types.MessageSystemAttributeNameMessageDeduplicationId)]; id != "" {
+					input.MessageDeduplicationId = awsV2.String(id)
+				}
+			}
+			if _, err := sqsServ.SendMessage(context.Background(), input); err != nil {
+				log.Info().Msg(fmt.Sprintf("Error in sending message to %v: %v", srcUrl, err))
+				return moved, err
+			}
+			if _, err := sqsServ.DeleteMessage(context.Background(), &sqs.DeleteMessageInput{QueueUrl: &dlqUrl, ReceiptHandle: m.ReceiptHandle}); err != nil {
+				log.Info().Msg(fmt.Sprintf("Error in deleting message from %v: %v", dlqUrl, err))
+				return moved, err
+			}
+			moved++
+		}
+	}
 }
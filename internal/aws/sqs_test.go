@@ -3,11 +3,14 @@ package aws
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 )
 
 type SQSGetAllQueuesAPI interface {
@@ -66,3 +69,82 @@ func TestGetAllQueues(t *testing.T) {
 		})
 	}
 }
+
+type mockPeekAPI struct {
+	receives [][]types.Message
+	reset    []*sqs.ChangeMessageVisibilityInput
+}
+
+func (m *mockPeekAPI) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	if len(m.receives) == 0 {
+		return &sqs.ReceiveMessageOutput{}, nil
+	}
+	msgs := m.receives[0]
+	m.receives = m.receives[1:]
+	return &sqs.ReceiveMessageOutput{Messages: msgs}, nil
+}
+
+func (m *mockPeekAPI) ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	m.reset = append(m.reset, params)
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
+
+func TestPeekMessagesResetsVisibility(t *testing.T) {
+	api := &mockPeekAPI{receives: [][]types.Message{
+		{
+			{MessageId: aws.String("m1"), ReceiptHandle: aws.String("r1")},
+			{MessageId: aws.String("m2"), ReceiptHandle: aws.String("r2")},
+		},
+		{
+			{MessageId: aws.String("m1"), ReceiptHandle: aws.String("r3")},
+		},
+	}}
+	msgs, err := peekMessages(context.TODO(), api, "http://localhost:4566/000000000000/queue-0")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if len(msgs) != 2 || msgs[0].ReceiptHandle != "r1" {
+		t.Errorf("expect m1 and m2 of a single receive, got %+v", msgs)
+	}
+	if len(api.receives) != 1 {
+		t.Errorf("expect a single receive per peek, got %d", 2-len(api.receives))
+	}
+	var handles []string
+	for _, r := range api.reset {
+		if r.VisibilityTimeout != 0 {
+			t.Errorf("expect a zero visibility timeout, got %d", r.VisibilityTimeout)
+		}
+		handles = append(handles, aws.ToString(r.ReceiptHandle))
+	}
+	if want := []string{"r1", "r2"}; !reflect.DeepEqual(handles, want) {
+		t.Errorf("expect visibility reset for %v, got %v", want, handles)
+	}
+}
+
+type mockQueueAttributesAPI map[string]string
+
+func (m mockQueueAttributesAPI) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	return &sqs.GetQueueAttributesOutput{Attributes: m}, nil
+}
+
+func TestQueueArnMatchesSubscription(t *testing.T) {
+	api := mockQueueAttributesAPI{
+		"QueueArn":                    "arn:aws:sqs:us-east-1:123:orders",
+		"ApproximateNumberOfMessages": "3",
+	}
+	arn, err := queueArn(context.TODO(), api, "http://localhost:4566/123/orders")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	subs := []snsTypes.Subscription{
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:billing"), Protocol: aws.String("sqs"), Endpoint: aws.String("arn:aws:sqs:us-east-1:123:orders")},
+		{TopicArn: aws.String("arn:aws:sns:us-east-1:123:alerts"), Protocol: aws.String("email"), Endpoint: aws.String("ops@example.com")},
+	}
+	if got, want := topicsFeeding(subs, arn), []string{"arn:aws:sns:us-east-1:123:billing"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("topicsFeeding() = %v, want %v", got, want)
+	}
+
+	if _, err := queueArn(context.TODO(), mockQueueAttributesAPI{}, "http://localhost:4566/123/orders"); err == nil {
+		t.Errorf("expect an error for a queue without arn")
+	}
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/rs/zerolog/log"
+)
+
+// ListParameters returns the SSM parameters of the region, values excluded.
+func ListParameters(cfg aws.Config) ([]ParameterResp, error) {
+	var params []ParameterResp
+	paginator := ssm.NewDescribeParametersPaginator(ssm.NewFromConfig(cfg), &ssm.DescribeParametersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error describing parameters, err: %v", err))
+			return nil, err
+		}
+		for _, p := range output.Parameters {
+			params = append(params, ParameterResp{
+				Name:         aws.ToString(p.Name),
+				Path:         aws.ToString(p.Name),
+				Type:         string(p.Type),
+				Version:      fmt.Sprintf("%d", p.Version),
+				Tier:         string(p.Tier),
+				LastModified: ecsLocalTime(p.LastModifiedDate),
+			})
+		}
+	}
+	return params, nil
+}
+
+// ParametersAt returns the parameters and folders directly under a path,
+// named relative to it. Parameters without a leading slash live under "/".
+func ParametersAt(params []ParameterResp, path string) []ParameterResp {
+	var level []ParameterResp
+	folders := make(map[string]bool)
+	for _, p := range params {
+		var rel string
+		switch {
+		case strings.HasPrefix(p.Path, path):
+			rel = strings.TrimPrefix(p.Path, path)
+		case path == "/" && !strings.HasPrefix(p.Path, "/"):
+			rel = p.Path
+		default:
+			continue
+		}
+		if i := strings.Index(rel, "/"); i > 0 {
+			folder := rel[:i]
+			if !folders[folder] {
+				folders[folder] = true
+				level = append(level, ParameterResp{
+					Name:         folder,
+					Path:         path + folder + "/",
+					Type:         internal.FOLDER_TYPE,
+					Version:      internal.NONE,
+					Tier:         internal.NONE,
+					LastModified: internal.NONE,
+				})
+			}
+			continue
+		}
+		p.Name = rel
+		level = append(level, p)
+	}
+	return level
+}
+
+// GetParameter describes a parameter, its value excluded.
+func GetParameter(cfg aws.Config, name string) (*ssmTypes.ParameterMetadata, error) {
+	output, err := ssm.NewFromConfig(cfg).DescribeParameters(context.TODO(), &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmTypes.ParameterStringFilter{
+			{Key: aws.String("Name"), Option: aws.String("Equals"), Values: []string{name}},
+		},
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error describing parameter %v, err: %v", name, err))
+		return nil, err
+	}
+	if len(output.Parameters) == 0 {
+		return nil, fmt.Errorf("parameter %s not found", name)
+	}
+	return &output.Parameters[0], nil
+}
+
+// GetParameterValue returns the decrypted value of a parameter, at the given
+// version when it isn't empty. The value must never be logged.
+func GetParameterValue(cfg aws.Config, name, version string) (string, error) {
+	selector := name
+	if version != "" {
+		selector = name + ":" + version
+	}
+	output, err := ssm.NewFromConfig(cfg).GetParameter(context.TODO(), &ssm.GetParameterInput{
+		Name:           &selector,
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("Error getting value of parameter %v, err: %v", selector, err))
+		return "", err
+	}
+	return aws.ToString(output.Parameter.Value), nil
+}
+
+// ListParameterVersions returns the history of a parameter, newest first and
+// values excluded.
+func ListParameterVersions(cfg aws.Config, name string) ([]ParameterVersionResp, error) {
+	var versions []ParameterVersionResp
+	paginator := ssm.NewGetParameterHistoryPaginator(ssm.NewFromConfig(cfg), &ssm.GetParameterHistoryInput{
+		Name: &name,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error getting history of parameter %v, err: %v", name, err))
+			return nil, err
+		}
+		for _, p := range output.Parameters {
+			versions = append(versions, ParameterVersionResp{
+				Version:      fmt.Sprintf("%d", p.Version),
+				Type:         string(p.Type),
+				Tier:         string(p.Tier),
+				Labels:       strings.Join(p.Labels, ","),
+				ModifiedBy:   aws.ToString(p.LastModifiedUser),
+				LastModified: ecsLocalTime(p.LastModifiedDate),
+			})
+		}
+	}
+	// History comes oldest first, versions are listed newest first.
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	return versions, nil
+}
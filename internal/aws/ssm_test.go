@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal"
+)
+
+func TestParametersAt(t *testing.T) {
+	params := []ParameterResp{
+		{Name: "/app/db/password", Path: "/app/db/password", Type: "SecureString"},
+		{Name: "/app/db/user", Path: "/app/db/user", Type: "String"},
+		{Name: "/app/url", Path: "/app/url", Type: "String"},
+		{Name: "legacy", Path: "legacy", Type: "String"},
+	}
+	folder := func(name, path string) ParameterResp {
+		return ParameterResp{Name: name, Path: path, Type: internal.FOLDER_TYPE, Version: internal.NONE, Tier: internal.NONE, LastModified: internal.NONE}
+	}
+
+	uu := map[string]struct {
+		path string
+		want []ParameterResp
+	}{
+		"root": {path: "/", want: []ParameterResp{
+			folder("app", "/app/"),
+			{Name: "legacy", Path: "legacy", Type: "String"},
+		}},
+		"app": {path: "/app/", want: []ParameterResp{
+			folder("db", "/app/db/"),
+			{Name: "url", Path: "/app/url", Type: "String"},
+		}},
+		"db": {path: "/app/db/", want: []ParameterResp{
+			{Name: "password", Path: "/app/db/password", Type: "SecureString"},
+			{Name: "user", Path: "/app/db/user", Type: "String"},
+		}},
+		"missing": {path: "/other/", want: nil},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if got := ParametersAt(params, u.path); !reflect.DeepEqual(got, u.want) {
+				t.Errorf("ParametersAt() = %v, want %v", got, u.want)
+			}
+		})
+	}
+}
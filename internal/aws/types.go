@@ -2,7 +2,9 @@ package aws
 
 import (
 	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
@@ -84,6 +86,60 @@ type SQSResp struct {
 	MaxMessageSize    string
 }
 
+type SQSMessageResp struct {
+	MessageId         string
+	ReceiptHandle     string
+	Body              string
+	Sent              string
+	ReceiveCount      string
+	GroupId           string
+	Attributes        map[string]string
+	MessageAttributes map[string]sqsTypes.MessageAttributeValue
+}
+
+type LambdaVersionResp struct {
+	Version      string
+	Description  string
+	Runtime      string
+	CodeSize     string
+	CodeSha256   string
+	LastModified string
+}
+
+type LambdaAliasResp struct {
+	Name            string
+	FunctionVersion string
+	Routing         string
+	Description     string
+	AliasArn        string
+}
+
+type LambdaEventSourceResp struct {
+	UUID           string
+	Source         string
+	EventSourceArn string
+	State          string
+	BatchSize      string
+	LastResult     string
+	LastModified   string
+}
+
+type LambdaLayerResp struct {
+	Name     string
+	Version  string
+	CodeSize string
+	Arn      string
+}
+
+type LambdaInvokeResp struct {
+	InvocationType  string
+	StatusCode      int32
+	FunctionError   string
+	ExecutedVersion string
+	LogTail         string
+	Payload         string
+}
+
 type Snapshot struct {
 	SnapshotId string
 	OwnerId    string
@@ -150,7 +206,521 @@ type EcsServiceResp struct {
 	ServiceArn     string
 }
 
+type EcsDeploymentResp struct {
+	Id                 string
+	Status             string
+	RolloutState       string
+	RolloutStateReason string
+	TaskDefinition     string
+	DesiredCount       int32
+	RunningCount       int32
+	PendingCount       int32
+	FailedTasks        int32
+	CircuitBreaker     string
+	CreatedAt          string
+	UpdatedAt          string
+}
+
+type EcsServiceEventResp struct {
+	CreatedAt string
+	Message   string
+}
+
+type EcsTaskDefinitionFamilyResp struct {
+	Family string
+}
+
+type EcsTaskDefinitionResp struct {
+	Name     string
+	Family   string
+	Revision string
+	Arn      string
+}
+
+type EcsLogStreamResp struct {
+	Group  string
+	Stream string
+	Region string
+}
+
+type LogEventResp struct {
+	Timestamp string
+	Message   string
+}
+
 type EcsTaskResp struct {
 	TaskId string
 	*ecsTypes.Task
 }
+
+type EksClusterResp struct {
+	Name                 string
+	Version              string
+	Status               string
+	PlatformVersion      string
+	Endpoint             string
+	Arn                  string
+	CertificateAuthority string
+	CreatedAt            string
+}
+
+type EksNodeGroupResp struct {
+	Name          string
+	Type          string
+	Status        string
+	Scaling       string
+	InstanceTypes string
+	AmiType       string
+	CapacityType  string
+	Version       string
+	Selectors     string
+	CreatedAt     string
+}
+
+type EcrRepositoryResp struct {
+	Name          string
+	Uri           string
+	ScanOnPush    string
+	TagMutability string
+	ImageCount    string
+	CreatedAt     string
+	Arn           string
+}
+
+type EcrImageResp struct {
+	Tags       string
+	Digest     string
+	PushedAt   string
+	Size       string
+	ScanStatus string
+	Findings   string
+}
+
+type EcrImageFindingsResp struct {
+	Digest            string
+	ScanStatus        string
+	StatusDescription string `json:",omitempty"`
+	SeverityCounts    map[string]int32
+	Findings          []EcrFindingResp
+}
+
+type EcrFindingResp struct {
+	Name        string
+	Severity    string
+	Packages    []string `json:",omitempty"`
+	Uri         string
+	Description string
+}
+
+type LoadBalancerResp struct {
+	Name      string
+	Type      string
+	Scheme    string
+	State     string
+	DNSName   string
+	VpcId     string
+	CreatedAt string
+	Arn       string
+}
+
+type ElbRuleResp struct {
+	Id           string
+	Listener     string
+	Priority     string
+	Conditions   string
+	Actions      string
+	TargetGroups string
+	Raw          interface{}
+}
+
+type ElbTargetHealthResp struct {
+	Target      string
+	TargetGroup string
+	State       string
+	Reason      string
+	Description string
+	Raw         interface{}
+}
+
+type AsgResp struct {
+	Name               string
+	Min                string
+	Desired            string
+	Max                string
+	Instances          string
+	LaunchTemplate     string
+	HealthCheckType    string
+	SuspendedProcesses string
+	CreatedAt          string
+	Arn                string
+}
+
+type AsgInstanceResp struct {
+	EC2Resp
+	LifecycleState string
+	HealthStatus   string
+}
+
+type AsgActivityResp struct {
+	Id            string
+	Status        string
+	StartTime     string
+	EndTime       string
+	Description   string
+	StatusMessage string
+	Cause         string
+}
+
+type HostedZoneResp struct {
+	Id      string
+	Name    string
+	Type    string
+	Records string
+	Comment string
+}
+
+type HostedZoneDescription struct {
+	HostedZone    *r53Types.HostedZone
+	DelegationSet *r53Types.DelegationSet
+	VPCs          []r53Types.VPC
+}
+
+type RecordSetResp struct {
+	Name          string
+	Type          string
+	TTL           string
+	Values        []string
+	Alias         string
+	Routing       string
+	HealthCheckId string
+}
+
+type RecordSetDescription struct {
+	RecordSets   []r53Types.ResourceRecordSet
+	HealthChecks []HealthCheckDescription
+}
+
+type HealthCheckDescription struct {
+	HealthCheck  *r53Types.HealthCheck
+	Observations []r53Types.HealthCheckObservation
+}
+
+type RecordResolution struct {
+	Name       string
+	Type       string
+	Configured []string
+	Resolved   []string
+	Missing    []string
+	Unexpected []string
+}
+
+type StackResp struct {
+	Name        string
+	Status      string
+	Drift       string
+	CreatedAt   string
+	UpdatedAt   string
+	Description string
+}
+
+type StackResourceResp struct {
+	LogicalId  string
+	PhysicalId string
+	Type       string
+	Status     string
+	Drift      string
+	UpdatedAt  string
+	Reason     string
+}
+
+type StackEventResp struct {
+	Id        string
+	Timestamp string
+	LogicalId string
+	Type      string
+	Status    string
+	Reason    string
+	Raw       interface{}
+}
+
+type SecretResp struct {
+	Name        string
+	Rotation    string
+	LastChanged string
+	KmsKey      string
+	Description string
+}
+
+type SecretVersionResp struct {
+	VersionId    string
+	Stages       string
+	CreatedAt    string
+	LastAccessed string
+}
+
+type ParameterResp struct {
+	Name         string
+	Path         string
+	Type         string
+	Version      string
+	Tier         string
+	LastModified string
+}
+
+type ParameterVersionResp struct {
+	Version      string
+	Type         string
+	Tier         string
+	Labels       string
+	ModifiedBy   string
+	LastModified string
+}
+
+type KmsKeyResp struct {
+	KeyId     string
+	Alias     string
+	State     string
+	Spec      string
+	Rotation  string
+	Manager   string
+	CreatedAt string
+}
+
+type CertificateResp struct {
+	Id       string
+	Domain   string
+	SANs     string
+	Status   string
+	Type     string
+	InUseBy  string
+	NotAfter string
+	DaysLeft string
+	Arn      string
+}
+
+type TopicResp struct {
+	Name          string
+	Arn           string
+	Type          string
+	DisplayName   string
+	Subscriptions string
+	Pending       string
+}
+
+type SubscriptionResp struct {
+	Id           string
+	Protocol     string
+	Endpoint     string
+	Pending      string
+	FilterPolicy string
+	Arn          string
+}
+
+type EventBusResp struct {
+	Name  string
+	Rules string
+	Arn   string
+}
+
+type EventRuleResp struct {
+	Name        string
+	State       string
+	Trigger     string
+	Targets     string
+	ManagedBy   string
+	Description string
+}
+
+type EventTargetResp struct {
+	Id         string
+	Arn        string
+	Input      string
+	Role       string
+	DeadLetter string
+	Raw        interface{}
+}
+
+type ScheduleResp struct {
+	Name         string
+	Group        string
+	State        string
+	Expression   string
+	Timezone     string
+	Target       string
+	LastModified string
+}
+
+type StateMachineResp struct {
+	Name    string
+	Type    string
+	Created string
+	Arn     string
+}
+
+type ExecutionResp struct {
+	Name     string
+	Status   string
+	Started  string
+	Stopped  string
+	Duration string
+	Arn      string
+}
+
+type ExecutionEventResp struct {
+	Id        string
+	Timestamp string
+	Type      string
+	State     string
+	Detail    string
+	Raw       interface{}
+}
+
+// ExecutionPathResp holds what is needed to draw the path of an execution
+// through its state machine.
+type ExecutionPathResp struct {
+	Definition string
+	Visited    map[string]bool
+	Failed     string
+}
+
+type KinesisStreamResp struct {
+	Name       string
+	Status     string
+	Mode       string
+	Shards     string
+	Retention  string
+	Consumers  string
+	Encryption string
+	Created    string
+}
+
+type ShardResp struct {
+	ShardId      string
+	State        string
+	Parent       string
+	StartingHash string
+	EndingHash   string
+	StartingSeq  string
+	Raw          interface{}
+}
+
+type DeliveryStreamResp struct {
+	Name        string
+	Status      string
+	Source      string
+	Destination string
+	Buffering   string
+	Created     string
+}
+
+type CacheResp struct {
+	Id       string
+	Kind     string
+	Engine   string
+	NodeType string
+	Nodes    string
+	Shards   string
+	Status   string
+	Endpoint string
+}
+
+type CacheNodeResp struct {
+	Id       string
+	Role     string
+	Shard    string
+	Zone     string
+	Status   string
+	Endpoint string
+	Created  string
+	Raw      interface{}
+}
+
+type CacheDetailsResp struct {
+	ParameterGroup string
+	SecurityGroups []string
+	Cache          interface{}
+}
+
+type ApiResp struct {
+	Id           string
+	Name         string
+	Protocol     string
+	EndpointType string
+	Created      string
+	Description  string
+	Raw          interface{}
+}
+
+type ApiStageResp struct {
+	Stage      string
+	Deployment string
+	Throttling string
+	Logging    string
+	AccessLog  string
+	Updated    string
+	InvokeUrl  string
+	Raw        interface{}
+}
+
+type ApiRouteResp struct {
+	Route         string
+	Integration   string
+	Target        string
+	Authorization string
+	Raw           interface{}
+}
+
+type DistributionResp struct {
+	Id         string
+	Domain     string
+	Aliases    string
+	Status     string
+	PriceClass string
+	Origins    string
+	Comment    string
+	Raw        interface{}
+}
+
+type CacheBehaviorResp struct {
+	PathPattern    string
+	Precedence     string
+	Origin         string
+	ViewerProtocol string
+	Methods        string
+	CachePolicy    string
+	Compress       string
+	Raw            interface{}
+}
+
+type FileSystemResp struct {
+	Id           string
+	Name         string
+	Size         string
+	Throughput   string
+	Performance  string
+	Encrypted    string
+	Lifecycle    string
+	MountTargets string
+	State        string
+	Raw          interface{}
+}
+
+type MountTargetResp struct {
+	Id             string
+	Subnet         string
+	Zone           string
+	IpAddress      string
+	State          string
+	VpcId          string
+	SecurityGroups string
+	Raw            interface{}
+}
+
+type AccessPointResp struct {
+	Id        string
+	Name      string
+	Path      string
+	PosixUser string
+	State     string
+	Raw       interface{}
+}
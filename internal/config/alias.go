@@ -156,6 +156,27 @@ func (a *Aliases) loadDefaultAliases(cloud string) {
 	a.declare(internal.Help, internal.QuestionMark, internal.LowercaseH)
 	a.declare(internal.Quit, internal.LowercaseQ, internal.QFactorial, internal.UppercaseQ)
 	a.declare(internal.LowercaseEcsCluster, internal.UppercaseEcsCluster)
+	a.declare(internal.LowercaseEcsTaskDefinitions, internal.UppercaseEcsTaskDefinitions)
+	a.declare(internal.LowercaseEks, internal.UppercaseEks)
+	a.declare(internal.LowercaseEcr, internal.UppercaseEcr)
+	a.declare(internal.LowercaseElb, internal.UppercaseElb)
+	a.declare(internal.LowercaseAsg, internal.UppercaseAsg)
+	a.declare(internal.LowercaseR53, internal.UppercaseR53)
+	a.declare(internal.LowercaseCfn, internal.UppercaseCfn)
+	a.declare(internal.LowercaseSecrets, internal.UppercaseSecrets)
+	a.declare(internal.LowercaseSsmParameters, internal.UppercaseSsmParameters)
+	a.declare(internal.LowercaseKms, internal.UppercaseKms)
+	a.declare(internal.LowercaseAcm, internal.UppercaseAcm)
+	a.declare(internal.LowercaseSns, internal.UppercaseSns)
+	a.declare(internal.LowercaseEvents, internal.UppercaseEvents)
+	a.declare(internal.LowercaseSchedules, internal.UppercaseSchedules)
+	a.declare(internal.LowercaseSfn, internal.UppercaseSfn)
+	a.declare(internal.LowercaseKinesis, internal.UppercaseKinesis)
+	a.declare(internal.LowercaseFirehose, internal.UppercaseFirehose)
+	a.declare(internal.LowercaseCache, internal.UppercaseCache)
+	a.declare(internal.LowercaseApigw, internal.UppercaseApigw)
+	a.declare(internal.LowercaseCf, internal.UppercaseCf)
+	a.declare(internal.LowercaseEfs, internal.UppercaseEfs)
 	// a.declare(internal.Alias,internal.Aliases, internal.LowercaseA)
 }
 
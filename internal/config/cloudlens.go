@@ -1,5 +1,24 @@
 package config
 
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultDownloadDir is the download directory name under ~/cloudlens.
+	DefaultDownloadDir = "downloads"
+	// DefaultDownloadWorkers is the default number of parallel downloads.
+	DefaultDownloadWorkers = 4
+	// DefaultSSHUser is the default user for ssh sessions to instances.
+	DefaultSSHUser = "ec2-user"
+	// DefaultCertExpiryDays is the default window certificates are flagged as expiring in.
+	DefaultCertExpiryDays = 30
+)
+
 type Active struct {
 	Profile string `yaml:"profile"`
 	Region  string `yaml:"region"`
@@ -12,9 +31,64 @@ type Cloudlens struct {
 	Logoless    bool    `yaml:"logoless"`
 	Crumbsless  bool    `yaml:"crumbsless"`
 	Active      *Active `yaml:"active"`
+	// DownloadDir is where bucket objects are downloaded to.
+	DownloadDir string `yaml:"downloadDir"`
+	// DownloadWorkers is the number of objects downloaded in parallel.
+	DownloadWorkers int `yaml:"downloadWorkers"`
+	// SSHUser is the user ssh sessions to instances log in as.
+	SSHUser string `yaml:"sshUser"`
+	// SSHKey is the private key ssh sessions to instances use.
+	SSHKey string `yaml:"sshKey"`
+	// CertExpiryDays is the number of days before expiry certificates are flagged.
+	CertExpiryDays int `yaml:"certExpiryDays"`
 }
 
 // NewCloudlens create a new Cloudlens configuration.
 func NewCloudlens() *Cloudlens {
 	return &Cloudlens{}
 }
+
+// GetDownloadDir returns the configured download directory or the default one.
+func (c *Cloudlens) GetDownloadDir() string {
+	if c.DownloadDir != "" {
+		return ExpandHome(c.DownloadDir)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		log.Info().Msg(fmt.Sprintf("error in getting the machine's home directory: %v", err))
+		return DefaultDownloadDir
+	}
+	return filepath.Join(home, "cloudlens", DefaultDownloadDir)
+}
+
+// GetDownloadWorkers returns the configured number of download workers.
+func (c *Cloudlens) GetDownloadWorkers() int {
+	if c.DownloadWorkers <= 0 {
+		return DefaultDownloadWorkers
+	}
+	return c.DownloadWorkers
+}
+
+// GetSSHUser returns the configured ssh user or the default one.
+func (c *Cloudlens) GetSSHUser() string {
+	if c.SSHUser == "" {
+		return DefaultSSHUser
+	}
+	return c.SSHUser
+}
+
+// GetSSHKey returns the configured ssh private key path, if any.
+func (c *Cloudlens) GetSSHKey() string {
+	if c.SSHKey == "" {
+		return ""
+	}
+	return ExpandHome(c.SSHKey)
+}
+
+// GetCertExpiryDays returns the configured certificate expiry window in days.
+func (c *Cloudlens) GetCertExpiryDays() int {
+	if c.CertExpiryDays <= 0 {
+		return DefaultCertExpiryDays
+	}
+	return c.CertExpiryDays
+}
@@ -26,6 +26,11 @@ type Config struct {
 	AwsConfig awsV2.Config
 }
 
+// NewConfig returns a new configuration with defaults.
+func NewConfig() *Config {
+	return &Config{Cloudlens: NewCloudlens()}
+}
+
 // CloudlensHome returns Cloudlens configs home directory.
 func CloudlensHome() string {
 	if env := os.Getenv(CloudlensConfig); env != "" {
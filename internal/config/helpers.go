@@ -36,6 +36,18 @@ func EnsureFullPath(path string, mod os.FileMode) {
 	}
 }
 
+// ExpandHome resolves a leading ~ in path to the user home directory.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
 func LookupForValue(profiles []string, value string) bool {
 	for _, got := range profiles {
 		if strings.EqualFold(got, value) {
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const lambdaEventExt = ".json"
+
+// DefaultLambdaEvent is the test event name suggested when none is saved yet.
+const DefaultLambdaEvent = "default"
+
+// LambdaEventsDir returns the directory holding a function test events.
+func LambdaEventsDir(functionName string) string {
+	return filepath.Join(CloudlensHome(), "lambda", functionName, "events")
+}
+
+// LambdaEventPath returns the file path of a function test event.
+func LambdaEventPath(functionName, event string) (string, error) {
+	if event == "" || strings.ContainsAny(event, `/\`) || strings.HasPrefix(event, ".") {
+		return "", fmt.Errorf("invalid test event name %q", event)
+	}
+	return filepath.Join(LambdaEventsDir(functionName), event+lambdaEventExt), nil
+}
+
+// ListLambdaEvents returns the saved test event names of a function.
+func ListLambdaEvents(functionName string) ([]string, error) {
+	ee, err := os.ReadDir(LambdaEventsDir(functionName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if e.IsDir() || filepath.Ext(e.Name()) != lambdaEventExt {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(e.Name(), lambdaEventExt))
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// SfnInputPath returns the file path of the last execution input of a state machine.
+func SfnInputPath(machineName string) (string, error) {
+	if machineName == "" || strings.ContainsAny(machineName, `/\`) || strings.HasPrefix(machineName, ".") {
+		return "", fmt.Errorf("invalid state machine name %q", machineName)
+	}
+	return filepath.Join(CloudlensHome(), "sfn", machineName, "input.json"), nil
+}
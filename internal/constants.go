@@ -16,6 +16,33 @@ const (
 	ECSClusterName        ContextKey = "ecs_cluster_name"
 	ECSServiceName        ContextKey = "ecs_service_name"
 	ECSTaskId             ContextKey = "ecs_task_id"
+	ECSTaskStopped        ContextKey = "ecs_task_stopped"
+	ECSTaskDefinitionFamily ContextKey = "ecs_task_definition_family"
+	EKSClusterName        ContextKey = "eks_cluster_name"
+	ECRRepositoryName     ContextKey = "ecr_repository_name"
+	ECRRepositoryUri      ContextKey = "ecr_repository_uri"
+	ELBName               ContextKey = "elb_name"
+	ELBType               ContextKey = "elb_type"
+	ELBTargetGroups       ContextKey = "elb_target_groups"
+	ASGName               ContextKey = "asg_name"
+	R53HostedZoneId       ContextKey = "r53_hosted_zone_id"
+	CFNStackName          ContextKey = "cfn_stack_name"
+	SecretName            ContextKey = "secret_name"
+	SSMParameterPath      ContextKey = "ssm_parameter_path"
+	SSMParameterName      ContextKey = "ssm_parameter_name"
+	SNSTopicArn           ContextKey = "sns_topic_arn"
+	SNSEndpoint           ContextKey = "sns_endpoint"
+	EventBusName          ContextKey = "event_bus_name"
+	EventRuleName         ContextKey = "event_rule_name"
+	SFNStateMachineArn    ContextKey = "sfn_state_machine_arn"
+	SFNExecutionArn       ContextKey = "sfn_execution_arn"
+	KinesisStreamName     ContextKey = "kinesis_stream_name"
+	CacheKind             ContextKey = "cache_kind"
+	CacheId               ContextKey = "cache_id"
+	ApiId                 ContextKey = "api_id"
+	ApiProtocol           ContextKey = "api_protocol"
+	CFDistributionId      ContextKey = "cf_distribution_id"
+	EFSFileSystemId       ContextKey = "efs_file_system_id"
 	BucketName            ContextKey = "bucket_name"
 	StorageBucketName     ContextKey = "storage_bucket_name"
 	ObjectName            ContextKey = "object_name"
@@ -25,6 +52,9 @@ const (
 	GroupName             ContextKey = "group_name"
 	RoleName              ContextKey = "role_name"
 	VpcId                 ContextKey = "vpc_id"
+	SQSQueueUrl           ContextKey = "sqs_queue_url"
+	SQSPeekMessages       ContextKey = "sqs_peek_messages"
+	LambdaFunctionName    ContextKey = "lambda_function_name"
 	LowercaseY            string     = "y"
 	UppercaseY            string     = "Y"
 	LowercaseYes          string     = "yes"
@@ -39,7 +69,74 @@ const (
 	LowercaseEcsServices  string     = "ecs:s"
 	LowercaseEcsTasks     string     = "ecs:t"
 	LowercaseEcsContainer string     = "ecs:cn"
+	LowercaseEcsDeployments string     = "ecs:d"
 	UppercaseEcsCluster   string     = "ECS:C"
+	LowercaseEcsTaskDefinitions string     = "ecs:td"
+	UppercaseEcsTaskDefinitions string     = "ECS:TD"
+	LowercaseEcsTaskDefinitionRevisions string     = "ecs:tdr"
+	LowercaseEks          string     = "eks"
+	UppercaseEks          string     = "EKS"
+	LowercaseEksNodeGroups string     = "eks:ng"
+	LowercaseEcr          string     = "ecr"
+	UppercaseEcr          string     = "ECR"
+	LowercaseEcrImages    string     = "ecr:i"
+	LowercaseElb          string     = "elb"
+	UppercaseElb          string     = "ELB"
+	LowercaseElbRules     string     = "elb:l"
+	LowercaseElbTargets   string     = "elb:tg"
+	LowercaseAsg          string     = "asg"
+	UppercaseAsg          string     = "ASG"
+	LowercaseAsgInstances string     = "asg:i"
+	LowercaseAsgActivities string    = "asg:a"
+	LowercaseR53          string     = "r53"
+	UppercaseR53          string     = "R53"
+	LowercaseR53Records   string     = "r53:rr"
+	LowercaseCfn          string     = "cfn"
+	UppercaseCfn          string     = "CFN"
+	LowercaseCfnResources string     = "cfn:r"
+	LowercaseCfnEvents    string     = "cfn:e"
+	LowercaseSecrets      string     = "secrets"
+	UppercaseSecrets      string     = "SECRETS"
+	LowercaseSecretVersions string   = "secrets:v"
+	LowercaseSsmParameters string    = "ssm:p"
+	UppercaseSsmParameters string    = "SSM:P"
+	LowercaseSsmParameterVersions string = "ssm:p:v"
+	LowercaseKms          string     = "kms"
+	UppercaseKms          string     = "KMS"
+	LowercaseAcm          string     = "acm"
+	UppercaseAcm          string     = "ACM"
+	LowercaseSns          string     = "sns"
+	UppercaseSns          string     = "SNS"
+	LowercaseSnsSubscriptions string = "sns:s"
+	LowercaseEvents       string     = "events"
+	UppercaseEvents       string     = "EVENTS"
+	LowercaseEventRules   string     = "events:r"
+	LowercaseEventTargets string     = "events:t"
+	LowercaseSchedules    string     = "schedules"
+	UppercaseSchedules    string     = "SCHEDULES"
+	LowercaseSfn          string     = "sfn"
+	UppercaseSfn          string     = "SFN"
+	LowercaseSfnExecutions string    = "sfn:e"
+	LowercaseSfnHistory   string     = "sfn:h"
+	LowercaseKinesis      string     = "kinesis"
+	UppercaseKinesis      string     = "KINESIS"
+	LowercaseKinesisShards string    = "kinesis:s"
+	LowercaseFirehose     string     = "firehose"
+	UppercaseFirehose     string     = "FIREHOSE"
+	LowercaseCache        string     = "cache"
+	UppercaseCache        string     = "CACHE"
+	LowercaseCacheNodes   string     = "cache:n"
+	LowercaseApigw        string     = "apigw"
+	UppercaseApigw        string     = "APIGW"
+	LowercaseApiStages    string     = "apigw:s"
+	LowercaseApiRoutes    string     = "apigw:r"
+	LowercaseCf           string     = "cf"
+	UppercaseCf           string     = "CF"
+	LowercaseCfBehaviors  string     = "cf:b"
+	LowercaseEfs          string     = "efs"
+	UppercaseEfs          string     = "EFS"
+	LowercaseEfsMounts    string     = "efs:m"
+	LowercaseEfsAccess    string     = "efs:a"
 	LowercaseS3           string     = "s3"
 	UppercaseS3           string     = "S3"
 	LowercaseEBS          string     = "ebs"
@@ -60,12 +157,17 @@ const (
 	UppercaseEc2Image     string     = "Ec2:I"
 	LowercaseSQS          string     = "sqs"
 	UppercaseSQS          string     = "SQS"
+	LowercaseSQSMessages  string     = "sqs:m"
 	LowercaseVPC          string     = "vpc"
 	UppercaseVPC          string     = "VPC"
 	LowercaseSubnet       string     = "subnet"
 	UppercaseSubnet       string     = "SUBNET"
 	LowercaseLamda        string     = "lambda"
 	UppercaseLamda        string     = "LAMBDA"
+	LowercaseLambdaVersions string     = "lambda:v"
+	LowercaseLambdaAliases string     = "lambda:a"
+	LowercaseLambdaEventSources string     = "lambda:esm"
+	LowercaseLambdaLayers string     = "lambda:l"
 	LowercaseStorage      string     = "storage"
 	UppercaseStorage      string     = "STORAGE"
 	LowerVmInstance      string     = "vm"
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Certificates struct {
+	Accessor
+	ctx context.Context
+
+	mx   sync.RWMutex
+	arns map[string]string
+}
+
+func (c *Certificates) Init(ctx context.Context) {
+	c.ctx = ctx
+}
+
+func (c *Certificates) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	certs, err := aws.ListCertificates(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list certificates: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	c.mx.Lock()
+	c.arns = make(map[string]string, len(certs))
+	objs := make([]Object, len(certs))
+	for i, obj := range certs {
+		c.arns[obj.Id] = obj.Arn
+		objs[i] = obj
+	}
+	c.mx.Unlock()
+	return objs, nil
+}
+
+func (c *Certificates) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a certificate given its id.
+func (c *Certificates) Describe(id string) (string, error) {
+	cfg, ok := c.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	c.mx.RLock()
+	arn, ok := c.arns[id]
+	c.mx.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("certificate %s not found", id)
+	}
+	res, err := aws.GetCertificate(cfg, arn)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
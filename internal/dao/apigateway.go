@@ -0,0 +1,168 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Apis struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *Apis) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *Apis) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	apis, err := aws.ListApis(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list apis: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(apis))
+	for i, obj := range apis {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *Apis) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *Apis) Describe(apiId string) (string, error) {
+	cfg, ok := a.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	apis, err := aws.ListApis(cfg)
+	if err != nil {
+		return "", err
+	}
+	for _, api := range apis {
+		if api.Id == apiId {
+			return toJSON(api.Raw)
+		}
+	}
+	return "", fmt.Errorf("api %s not found", apiId)
+}
+
+type ApiStages struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *ApiStages) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *ApiStages) List(ctx context.Context) ([]Object, error) {
+	cfg, protocol, id, err := apiCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := aws.ListApiStages(cfg, protocol, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list api stages: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(stages))
+	for i, obj := range stages {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *ApiStages) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *ApiStages) Describe(stage string) (string, error) {
+	cfg, protocol, id, err := apiCtx(a.ctx)
+	if err != nil {
+		return "", err
+	}
+	stages, err := aws.ListApiStages(cfg, protocol, id)
+	if err != nil {
+		return "", err
+	}
+	for _, s := range stages {
+		if s.Stage == stage {
+			return toJSON(s.Raw)
+		}
+	}
+	return "", fmt.Errorf("stage %s of %s not found", stage, id)
+}
+
+type ApiRoutes struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *ApiRoutes) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *ApiRoutes) List(ctx context.Context) ([]Object, error) {
+	cfg, protocol, id, err := apiCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := aws.ListApiRoutes(cfg, protocol, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list api routes: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(routes))
+	for i, obj := range routes {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *ApiRoutes) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *ApiRoutes) Describe(route string) (string, error) {
+	cfg, protocol, id, err := apiCtx(a.ctx)
+	if err != nil {
+		return "", err
+	}
+	routes, err := aws.ListApiRoutes(cfg, protocol, id)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range routes {
+		if r.Route == route {
+			return toJSON(r.Raw)
+		}
+	}
+	return "", fmt.Errorf("route %s of %s not found", route, id)
+}
+
+func apiCtx(ctx context.Context) (awsV2.Config, string, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	protocol, _ := ctx.Value(internal.ApiProtocol).(string)
+	id, ok := ctx.Value(internal.ApiId).(string)
+	if !ok || id == "" {
+		return cfg, "", "", fmt.Errorf("failed to get api id from context")
+	}
+	return cfg, protocol, id, nil
+}
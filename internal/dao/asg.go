@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type AutoScalingGroups struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *AutoScalingGroups) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *AutoScalingGroups) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	groups, err := aws.ListAutoScalingGroups(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list auto scaling groups: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(groups))
+	for i, obj := range groups {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *AutoScalingGroups) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *AutoScalingGroups) Describe(name string) (string, error) {
+	cfg, ok := a.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetAutoScalingGroup(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type AsgInstances struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *AsgInstances) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *AsgInstances) List(ctx context.Context) ([]Object, error) {
+	cfg, name, err := asgCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := aws.ListAsgInstances(cfg, name)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list auto scaling group instances: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(instances))
+	for i, obj := range instances {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *AsgInstances) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *AsgInstances) Describe(instanceId string) (string, error) {
+	cfg, ok := a.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	return aws.GetSingleInstance(cfg, instanceId), nil
+}
+
+type AsgActivities struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *AsgActivities) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *AsgActivities) List(ctx context.Context) ([]Object, error) {
+	cfg, name, err := asgCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := aws.ListScalingActivities(cfg, name)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list scaling activities: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(activities))
+	for i, obj := range activities {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *AsgActivities) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *AsgActivities) Describe(id string) (string, error) {
+	cfg, name, err := asgCtx(a.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetScalingActivity(cfg, name, id)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func asgCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	name, ok := ctx.Value(internal.ASGName).(string)
+	if !ok || name == "" {
+		return cfg, "", fmt.Errorf("failed to get auto scaling group name from context")
+	}
+	return cfg, name, nil
+}
@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Stacks struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *Stacks) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Stacks) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	stacks, err := aws.ListStacks(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list stacks: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(stacks))
+	for i, obj := range stacks {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *Stacks) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (s *Stacks) Describe(name string) (string, error) {
+	cfg, ok := s.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetStack(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type StackResources struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *StackResources) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *StackResources) List(ctx context.Context) ([]Object, error) {
+	cfg, stack, err := stackCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := aws.ListStackResources(cfg, stack)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list stack resources: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(resources))
+	for i, obj := range resources {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *StackResources) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (s *StackResources) Describe(logicalId string) (string, error) {
+	cfg, stack, err := stackCtx(s.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetStackResource(cfg, stack, logicalId)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type StackEvents struct {
+	Accessor
+	ctx context.Context
+
+	mx     sync.RWMutex
+	stack  string
+	events []aws.StackEventResp
+}
+
+func (s *StackEvents) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *StackEvents) List(ctx context.Context) ([]Object, error) {
+	cfg, stack, err := stackCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	key := cfg.Region + "/" + stack
+	if s.stack != key {
+		s.stack, s.events = key, nil
+	}
+	events, err := aws.ListStackEvents(cfg, stack, s.events)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list stack events: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	s.events = events
+	objs := make([]Object, len(events))
+	for i, obj := range events {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *StackEvents) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a stack event of the last listing.
+func (s *StackEvents) Describe(eventId string) (string, error) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	for _, e := range s.events {
+		if e.Id == eventId {
+			return toJSON(e.Raw)
+		}
+	}
+	return "", fmt.Errorf("stack event %s not found", eventId)
+}
+
+func stackCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	stack, ok := ctx.Value(internal.CFNStackName).(string)
+	if !ok || stack == "" {
+		return cfg, "", fmt.Errorf("failed to get stack name from context")
+	}
+	return cfg, stack, nil
+}
@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Distributions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (d *Distributions) Init(ctx context.Context) {
+	d.ctx = ctx
+}
+
+func (d *Distributions) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	distributions, err := aws.ListDistributions(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list distributions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(distributions))
+	for i, obj := range distributions {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (d *Distributions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (d *Distributions) Describe(id string) (string, error) {
+	cfg, ok := d.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	distributions, err := aws.ListDistributions(cfg)
+	if err != nil {
+		return "", err
+	}
+	for _, dist := range distributions {
+		if dist.Id == id {
+			return toJSON(dist.Raw)
+		}
+	}
+	return "", fmt.Errorf("distribution %s not found", id)
+}
+
+type CacheBehaviors struct {
+	Accessor
+	ctx context.Context
+}
+
+func (c *CacheBehaviors) Init(ctx context.Context) {
+	c.ctx = ctx
+}
+
+func (c *CacheBehaviors) List(ctx context.Context) ([]Object, error) {
+	cfg, id, err := distributionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	behaviors, err := aws.ListCacheBehaviors(cfg, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list cache behaviors: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(behaviors))
+	for i, obj := range behaviors {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (c *CacheBehaviors) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (c *CacheBehaviors) Describe(pathPattern string) (string, error) {
+	cfg, id, err := distributionCtx(c.ctx)
+	if err != nil {
+		return "", err
+	}
+	behaviors, err := aws.ListCacheBehaviors(cfg, id)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range behaviors {
+		if b.PathPattern == pathPattern {
+			return toJSON(b.Raw)
+		}
+	}
+	return "", fmt.Errorf("cache behavior %s of %s not found", pathPattern, id)
+}
+
+func distributionCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	id, ok := ctx.Value(internal.CFDistributionId).(string)
+	if !ok || id == "" {
+		return cfg, "", fmt.Errorf("failed to get distribution id from context")
+	}
+	return cfg, id, nil
+}
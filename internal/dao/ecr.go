@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+// ecrImageCountTTL bounds how long repository image counts are reused, as
+// counting them pages through all of the images.
+const ecrImageCountTTL = 10 * time.Minute
+
+type ecrImageCount struct {
+	count string
+	at    time.Time
+}
+
+type ECRRepositories struct {
+	Accessor
+	ctx context.Context
+
+	mx     sync.Mutex
+	counts map[string]ecrImageCount
+}
+
+func (e *ECRRepositories) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *ECRRepositories) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	repos, err := aws.ListEcrRepositories(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list ECR repositories: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(repos))
+	for i, obj := range repos {
+		obj.ImageCount = e.imageCount(cfg, obj)
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+// imageCount returns the image count of a repository, counting its images
+// at most once per ecrImageCountTTL. Counts failing show as "-".
+func (e *ECRRepositories) imageCount(cfg awsV2.Config, repo aws.EcrRepositoryResp) string {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	if c, ok := e.counts[repo.Uri]; ok && time.Since(c.at) < ecrImageCountTTL {
+		return c.count
+	}
+	count, err := aws.CountEcrImages(cfg, repo.Name)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to count images of ECR repository %s", repo.Name)
+		return "-"
+	}
+	if e.counts == nil {
+		e.counts = make(map[string]ecrImageCount)
+	}
+	e.counts[repo.Uri] = ecrImageCount{count: fmt.Sprint(count), at: time.Now()}
+	return e.counts[repo.Uri].count
+}
+
+// ForgetImageCount drops the image count of a repository given its URI, once
+// its images changed.
+func (e *ECRRepositories) ForgetImageCount(uri string) {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	delete(e.counts, uri)
+}
+
+func (e *ECRRepositories) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *ECRRepositories) Describe(repo string) (string, error) {
+	cfg, ok := e.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetEcrRepository(cfg, repo)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type ECRImages struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *ECRImages) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *ECRImages) List(ctx context.Context) ([]Object, error) {
+	cfg, repo, err := ecrRepositoryCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	images, err := aws.ListEcrImages(cfg, repo)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list ECR images: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(images))
+	for i, obj := range images {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *ECRImages) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe lists the vulnerability findings of an image.
+func (e *ECRImages) Describe(digest string) (string, error) {
+	cfg, repo, err := ecrRepositoryCtx(e.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetEcrImageFindings(cfg, repo, digest)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func ecrRepositoryCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	repo, ok := ctx.Value(internal.ECRRepositoryName).(string)
+	if !ok || repo == "" {
+		return cfg, "", fmt.Errorf("failed to get ECR repository name from context")
+	}
+	return cfg, repo, nil
+}
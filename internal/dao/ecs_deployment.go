@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type ECSDeployments struct {
+	Accessor
+	ctx context.Context
+}
+
+func (ecsDeployments *ECSDeployments) Init(ctx context.Context) {
+	ecsDeployments.ctx = ctx
+}
+
+func (ecsDeployments *ECSDeployments) List(ctx context.Context) ([]Object, error) {
+	cfg, clusterName, serviceName, err := ecsServiceCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := aws.ListEcsDeployments(cfg, clusterName, serviceName)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list ECS deployments: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(deployments))
+	for i, obj := range deployments {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (ecsDeployments *ECSDeployments) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (ecsDeployments *ECSDeployments) Describe(deploymentId string) (string, error) {
+	cfg, clusterName, serviceName, err := ecsServiceCtx(ecsDeployments.ctx)
+	if err != nil {
+		return "", err
+	}
+	service, err := aws.GetEcsService(cfg, clusterName, serviceName)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range service.Deployments {
+		if awsV2.ToString(d.Id) == deploymentId {
+			return toJSON(d)
+		}
+	}
+	return "", fmt.Errorf("deployment %s not found in service %s", deploymentId, serviceName)
+}
+
+// ecsServiceCtx returns the session, cluster and service of an ECS service drill down.
+func ecsServiceCtx(ctx context.Context) (awsV2.Config, string, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	clusterName, ok := ctx.Value(internal.ECSClusterName).(string)
+	if !ok || clusterName == "" {
+		return cfg, "", "", fmt.Errorf("failed to get ECS cluster name from context")
+	}
+	serviceName, ok := ctx.Value(internal.ECSServiceName).(string)
+	if !ok || serviceName == "" {
+		return cfg, "", "", fmt.Errorf("failed to get ECS service name from context")
+	}
+	return cfg, clusterName, serviceName, nil
+}
@@ -39,7 +39,8 @@ func (ecsTasks *ECSTasks) List(ctx context.Context) ([]Object, error) {
 		return nil, fmt.Errorf(errMsg)
 	}
 
-	listEcsTasks, err := aws.ListEcsTasks(cfg, clusterName, serviceName)
+	stopped, _ := ctx.Value(internal.ECSTaskStopped).(bool)
+	listEcsTasks, err := aws.ListEcsTasks(cfg, clusterName, serviceName, stopped)
 	if err != nil {
 		errMsg = fmt.Sprintf("failed to list ECS tasks: %v", err)
 		log.Err(fmt.Errorf(errMsg))
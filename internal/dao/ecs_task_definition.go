@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type ECSTaskDefinitionFamilies struct {
+	Accessor
+	ctx context.Context
+}
+
+func (tdf *ECSTaskDefinitionFamilies) Init(ctx context.Context) {
+	tdf.ctx = ctx
+}
+
+func (tdf *ECSTaskDefinitionFamilies) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	families, err := aws.ListEcsTaskDefinitionFamilies(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list ECS task definition families: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(families))
+	for i, obj := range families {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (tdf *ECSTaskDefinitionFamilies) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes the latest active revision of a family.
+func (tdf *ECSTaskDefinitionFamilies) Describe(family string) (string, error) {
+	return describeTaskDefinition(tdf.ctx, family)
+}
+
+type ECSTaskDefinitions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (td *ECSTaskDefinitions) Init(ctx context.Context) {
+	td.ctx = ctx
+}
+
+func (td *ECSTaskDefinitions) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	family, ok := ctx.Value(internal.ECSTaskDefinitionFamily).(string)
+	if !ok || family == "" {
+		return nil, fmt.Errorf("failed to get ECS task definition family from context")
+	}
+	revisions, err := aws.ListEcsTaskDefinitions(cfg, family)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list ECS task definitions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(revisions))
+	for i, obj := range revisions {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (td *ECSTaskDefinitions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (td *ECSTaskDefinitions) Describe(name string) (string, error) {
+	return describeTaskDefinition(td.ctx, name)
+}
+
+func describeTaskDefinition(ctx context.Context, name string) (string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetEcsTaskDefinition(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type FileSystems struct {
+	Accessor
+	ctx context.Context
+}
+
+func (f *FileSystems) Init(ctx context.Context) {
+	f.ctx = ctx
+}
+
+func (f *FileSystems) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	fileSystems, err := aws.ListFileSystems(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list file systems: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(fileSystems))
+	for i, obj := range fileSystems {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (f *FileSystems) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (f *FileSystems) Describe(id string) (string, error) {
+	cfg, ok := f.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	fileSystems, err := aws.ListFileSystems(cfg)
+	if err != nil {
+		return "", err
+	}
+	for _, fs := range fileSystems {
+		if fs.Id == id {
+			return toJSON(fs.Raw)
+		}
+	}
+	return "", fmt.Errorf("file system %s not found", id)
+}
+
+type MountTargets struct {
+	Accessor
+	ctx context.Context
+}
+
+func (m *MountTargets) Init(ctx context.Context) {
+	m.ctx = ctx
+}
+
+func (m *MountTargets) List(ctx context.Context) ([]Object, error) {
+	cfg, id, err := fileSystemCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := aws.ListMountTargets(cfg, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list mount targets: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(targets))
+	for i, obj := range targets {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (m *MountTargets) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (m *MountTargets) Describe(targetId string) (string, error) {
+	cfg, id, err := fileSystemCtx(m.ctx)
+	if err != nil {
+		return "", err
+	}
+	targets, err := aws.ListMountTargets(cfg, id)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range targets {
+		if t.Id == targetId {
+			return toJSON(t.Raw)
+		}
+	}
+	return "", fmt.Errorf("mount target %s of %s not found", targetId, id)
+}
+
+type AccessPoints struct {
+	Accessor
+	ctx context.Context
+}
+
+func (a *AccessPoints) Init(ctx context.Context) {
+	a.ctx = ctx
+}
+
+func (a *AccessPoints) List(ctx context.Context) ([]Object, error) {
+	cfg, id, err := fileSystemCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessPoints, err := aws.ListAccessPoints(cfg, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list access points: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(accessPoints))
+	for i, obj := range accessPoints {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (a *AccessPoints) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (a *AccessPoints) Describe(accessPointId string) (string, error) {
+	cfg, id, err := fileSystemCtx(a.ctx)
+	if err != nil {
+		return "", err
+	}
+	accessPoints, err := aws.ListAccessPoints(cfg, id)
+	if err != nil {
+		return "", err
+	}
+	for _, ap := range accessPoints {
+		if ap.Id == accessPointId {
+			return toJSON(ap.Raw)
+		}
+	}
+	return "", fmt.Errorf("access point %s of %s not found", accessPointId, id)
+}
+
+func fileSystemCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	id, ok := ctx.Value(internal.EFSFileSystemId).(string)
+	if !ok || id == "" {
+		return cfg, "", fmt.Errorf("failed to get file system id from context")
+	}
+	return cfg, id, nil
+}
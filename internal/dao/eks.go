@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type EKSClusters struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *EKSClusters) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *EKSClusters) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	clusters, err := aws.ListEksClusters(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list EKS clusters: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(clusters))
+	for i, obj := range clusters {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *EKSClusters) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *EKSClusters) Describe(clusterName string) (string, error) {
+	cfg, ok := e.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetEksCluster(cfg, clusterName)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type EKSNodeGroups struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *EKSNodeGroups) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *EKSNodeGroups) List(ctx context.Context) ([]Object, error) {
+	cfg, clusterName, err := eksClusterCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := aws.ListEksNodeGroups(cfg, clusterName)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list EKS node groups: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(groups))
+	for i, obj := range groups {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *EKSNodeGroups) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *EKSNodeGroups) Describe(name string) (string, error) {
+	cfg, clusterName, err := eksClusterCtx(e.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetEksNodeGroup(cfg, clusterName, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func eksClusterCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	clusterName, ok := ctx.Value(internal.EKSClusterName).(string)
+	if !ok || clusterName == "" {
+		return cfg, "", fmt.Errorf("failed to get EKS cluster name from context")
+	}
+	return cfg, clusterName, nil
+}
@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Caches struct {
+	Accessor
+	ctx context.Context
+}
+
+func (c *Caches) Init(ctx context.Context) {
+	c.ctx = ctx
+}
+
+func (c *Caches) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	caches, err := aws.ListCaches(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list caches: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(caches))
+	for i, obj := range caches {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (c *Caches) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a cache given its kind/id path.
+func (c *Caches) Describe(path string) (string, error) {
+	cfg, ok := c.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	kind, id, ok := strings.Cut(path, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid cache path %s", path)
+	}
+	res, err := aws.GetCache(cfg, kind, id)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type CacheNodes struct {
+	Accessor
+	ctx context.Context
+}
+
+func (c *CacheNodes) Init(ctx context.Context) {
+	c.ctx = ctx
+}
+
+func (c *CacheNodes) List(ctx context.Context) ([]Object, error) {
+	cfg, kind, id, err := cacheCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := aws.ListCacheNodes(cfg, kind, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list cache nodes: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(nodes))
+	for i, obj := range nodes {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (c *CacheNodes) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (c *CacheNodes) Describe(nodeId string) (string, error) {
+	cfg, kind, id, err := cacheCtx(c.ctx)
+	if err != nil {
+		return "", err
+	}
+	nodes, err := aws.ListCacheNodes(cfg, kind, id)
+	if err != nil {
+		return "", err
+	}
+	for _, n := range nodes {
+		if n.Id == nodeId {
+			return toJSON(n.Raw)
+		}
+	}
+	return "", fmt.Errorf("node %s of %s not found", nodeId, id)
+}
+
+func cacheCtx(ctx context.Context) (awsV2.Config, string, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	kind, _ := ctx.Value(internal.CacheKind).(string)
+	id, ok := ctx.Value(internal.CacheId).(string)
+	if !ok || id == "" {
+		return cfg, "", "", fmt.Errorf("failed to get cache id from context")
+	}
+	return cfg, kind, id, nil
+}
@@ -0,0 +1,174 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type LoadBalancers struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *LoadBalancers) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *LoadBalancers) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	lbs, err := aws.ListLoadBalancers(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list load balancers: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(lbs))
+	for i, obj := range lbs {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (l *LoadBalancers) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a load balancer, the path being name/type.
+func (l *LoadBalancers) Describe(path string) (string, error) {
+	cfg, ok := l.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	name, lbType, _ := strings.Cut(path, "/")
+	res, err := aws.GetLoadBalancer(cfg, name, lbType)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type ElbRules struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *ElbRules) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *ElbRules) List(ctx context.Context) ([]Object, error) {
+	cfg, name, lbType, err := elbCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := aws.ListLoadBalancerRules(cfg, name, lbType)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list load balancer rules: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(rules))
+	for i, obj := range rules {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (l *ElbRules) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *ElbRules) Describe(id string) (string, error) {
+	cfg, name, lbType, err := elbCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	rules, err := aws.ListLoadBalancerRules(cfg, name, lbType)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range rules {
+		if r.Id == id {
+			return toJSON(r.Raw)
+		}
+	}
+	return "", fmt.Errorf("rule %s not found", id)
+}
+
+type ElbTargets struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *ElbTargets) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *ElbTargets) List(ctx context.Context) ([]Object, error) {
+	cfg, name, lbType, err := elbCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := aws.ListTargetHealth(cfg, name, lbType, elbTargetGroups(ctx))
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list target health: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(targets))
+	for i, obj := range targets {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (l *ElbTargets) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *ElbTargets) Describe(target string) (string, error) {
+	cfg, name, lbType, err := elbCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	targets, err := aws.ListTargetHealth(cfg, name, lbType, elbTargetGroups(l.ctx))
+	if err != nil {
+		return "", err
+	}
+	for _, t := range targets {
+		if t.Target == target {
+			return toJSON(t.Raw)
+		}
+	}
+	return "", fmt.Errorf("target %s not found", target)
+}
+
+func elbCtx(ctx context.Context) (awsV2.Config, string, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	name, ok := ctx.Value(internal.ELBName).(string)
+	if !ok || name == "" {
+		return cfg, "", "", fmt.Errorf("failed to get load balancer name from context")
+	}
+	lbType, _ := ctx.Value(internal.ELBType).(string)
+	return cfg, name, lbType, nil
+}
+
+func elbTargetGroups(ctx context.Context) []string {
+	tgs, _ := ctx.Value(internal.ELBTargetGroups).(string)
+	if tgs == "" {
+		return nil
+	}
+	return strings.Split(tgs, ",")
+}
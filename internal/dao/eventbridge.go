@@ -0,0 +1,223 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type EventBuses struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *EventBuses) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *EventBuses) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	buses, err := aws.ListEventBuses(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list event buses: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(buses))
+	for i, obj := range buses {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *EventBuses) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *EventBuses) Describe(name string) (string, error) {
+	cfg, ok := e.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	buses, err := aws.ListEventBuses(cfg)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range buses {
+		if b.Name == name {
+			return toJSON(b)
+		}
+	}
+	return "", fmt.Errorf("event bus %s not found", name)
+}
+
+type EventRules struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *EventRules) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *EventRules) List(ctx context.Context) ([]Object, error) {
+	cfg, bus, err := eventBusCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := aws.ListEventRules(cfg, bus)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list rules: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(rules))
+	for i, obj := range rules {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *EventRules) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *EventRules) Describe(rule string) (string, error) {
+	cfg, bus, err := eventBusCtx(e.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetEventRule(cfg, bus, rule)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type EventTargets struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *EventTargets) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *EventTargets) List(ctx context.Context) ([]Object, error) {
+	cfg, bus, rule, err := eventRuleCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := aws.ListEventRuleTargets(cfg, bus, rule)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list rule targets: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(targets))
+	for i, obj := range targets {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *EventTargets) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *EventTargets) Describe(id string) (string, error) {
+	cfg, bus, rule, err := eventRuleCtx(e.ctx)
+	if err != nil {
+		return "", err
+	}
+	targets, err := aws.ListEventRuleTargets(cfg, bus, rule)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range targets {
+		if t.Id == id {
+			return toJSON(t.Raw)
+		}
+	}
+	return "", fmt.Errorf("target %s of rule %s not found", id, rule)
+}
+
+type Schedules struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *Schedules) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Schedules) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	schedules, err := aws.ListSchedules(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list schedules: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(schedules))
+	for i, obj := range schedules {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *Schedules) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a schedule given its group/name path.
+func (s *Schedules) Describe(path string) (string, error) {
+	cfg, ok := s.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	group, name, ok := strings.Cut(path, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid schedule path %s", path)
+	}
+	res, err := aws.GetSchedule(cfg, group, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func eventBusCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	bus, ok := ctx.Value(internal.EventBusName).(string)
+	if !ok || bus == "" {
+		return cfg, "", fmt.Errorf("failed to get event bus name from context")
+	}
+	return cfg, bus, nil
+}
+
+func eventRuleCtx(ctx context.Context) (awsV2.Config, string, string, error) {
+	cfg, bus, err := eventBusCtx(ctx)
+	if err != nil {
+		return cfg, "", "", err
+	}
+	rule, ok := ctx.Value(internal.EventRuleName).(string)
+	if !ok || rule == "" {
+		return cfg, "", "", fmt.Errorf("failed to get rule name from context")
+	}
+	return cfg, bus, rule, nil
+}
@@ -1,6 +1,12 @@
 package dao
 
-import "regexp"
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// MaskedValue replaces sensitive values until they are revealed.
+const MaskedValue = "********"
 
 var (
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
@@ -13,3 +19,11 @@ func IsFuzzySelector(s string) bool {
 	}
 	return fuzzyRx.MatchString(s)
 }
+
+func toJSON(v interface{}) (string, error) {
+	res, err := json.MarshalIndent(v, "", " ")
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type KinesisStreams struct {
+	Accessor
+	ctx context.Context
+}
+
+func (k *KinesisStreams) Init(ctx context.Context) {
+	k.ctx = ctx
+}
+
+func (k *KinesisStreams) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	streams, err := aws.ListKinesisStreams(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list kinesis streams: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(streams))
+	for i, obj := range streams {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (k *KinesisStreams) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (k *KinesisStreams) Describe(name string) (string, error) {
+	cfg, ok := k.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetKinesisStream(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type Shards struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *Shards) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Shards) List(ctx context.Context) ([]Object, error) {
+	cfg, stream, err := kinesisStreamCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shards, err := aws.ListShards(cfg, stream)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list shards: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(shards))
+	for i, obj := range shards {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *Shards) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (s *Shards) Describe(shardId string) (string, error) {
+	cfg, stream, err := kinesisStreamCtx(s.ctx)
+	if err != nil {
+		return "", err
+	}
+	shards, err := aws.ListShards(cfg, stream)
+	if err != nil {
+		return "", err
+	}
+	for _, sh := range shards {
+		if sh.ShardId == shardId {
+			return toJSON(sh.Raw)
+		}
+	}
+	return "", fmt.Errorf("shard %s of %s not found", shardId, stream)
+}
+
+func kinesisStreamCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	stream, ok := ctx.Value(internal.KinesisStreamName).(string)
+	if !ok || stream == "" {
+		return cfg, "", fmt.Errorf("failed to get stream name from context")
+	}
+	return cfg, stream, nil
+}
+
+type DeliveryStreams struct {
+	Accessor
+	ctx context.Context
+}
+
+func (d *DeliveryStreams) Init(ctx context.Context) {
+	d.ctx = ctx
+}
+
+func (d *DeliveryStreams) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	streams, err := aws.ListDeliveryStreams(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list delivery streams: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(streams))
+	for i, obj := range streams {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (d *DeliveryStreams) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a delivery stream, Splunk HEC tokens masked.
+func (d *DeliveryStreams) Describe(name string) (string, error) {
+	cfg, ok := d.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetDeliveryStream(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	for _, dest := range res.Destinations {
+		if s := dest.SplunkDestinationDescription; s != nil && s.HECToken != nil {
+			s.HECToken = awsV2.String(MaskedValue)
+		}
+	}
+	return toJSON(res)
+}
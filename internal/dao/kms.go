@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type KmsKeys struct {
+	Accessor
+	ctx context.Context
+}
+
+func (k *KmsKeys) Init(ctx context.Context) {
+	k.ctx = ctx
+}
+
+func (k *KmsKeys) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	keys, err := aws.ListKmsKeys(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list kms keys: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(keys))
+	for i, obj := range keys {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (k *KmsKeys) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a key with its key policy.
+func (k *KmsKeys) Describe(keyId string) (string, error) {
+	cfg, ok := k.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetKmsKey(cfg, keyId)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
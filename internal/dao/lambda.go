@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/one2nc/cloudlens/internal"
 	"github.com/one2nc/cloudlens/internal/aws"
 	"github.com/rs/zerolog/log"
@@ -31,3 +33,99 @@ func (l *Lambda) List(ctx context.Context) ([]Object, error) {
 	}
 	return objs, err
 }
+
+func (l *Lambda) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// lambdaDescription represents the describe view of a function.
+type lambdaDescription struct {
+	FunctionName           string
+	FunctionArn            string
+	Description            string
+	Runtime                string
+	Handler                string
+	PackageType            string
+	Architectures          []lambdaTypes.Architecture
+	MemorySize             *int32
+	Timeout                *int32
+	EphemeralStorage       *lambdaTypes.EphemeralStorage
+	Role                   string
+	State                  string
+	LastUpdateStatus       string
+	Environment            map[string]string
+	VpcConfig              *lambdaTypes.VpcConfigResponse
+	ReservedConcurrency    *int32
+	ProvisionedConcurrency []lambdaTypes.ProvisionedConcurrencyConfigListItem
+	Layers                 []lambdaTypes.Layer
+	Tags                   map[string]string
+}
+
+func (l *Lambda) Describe(functionName string) (string, error) {
+	cfg, ok := l.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	fn, err := aws.GetLambdaFunction(cfg, functionName, "")
+	if err != nil {
+		return "", err
+	}
+	desc := newLambdaDescription(fn)
+	if desc.ProvisionedConcurrency, err = aws.GetLambdaProvisionedConcurrency(cfg, functionName); err != nil {
+		log.Warn().Msgf("unable to fetch provisioned concurrency of %s: %v", functionName, err)
+	}
+	return toJSON(desc)
+}
+
+// newLambdaDescription describes a function, its environment values masked
+// since they are only revealed on demand.
+func newLambdaDescription(fn *lambda.GetFunctionOutput) lambdaDescription {
+	c := fn.Configuration
+	desc := lambdaDescription{
+		FunctionName:     awsV2.ToString(c.FunctionName),
+		FunctionArn:      awsV2.ToString(c.FunctionArn),
+		Description:      awsV2.ToString(c.Description),
+		Runtime:          string(c.Runtime),
+		Handler:          awsV2.ToString(c.Handler),
+		PackageType:      string(c.PackageType),
+		Architectures:    c.Architectures,
+		MemorySize:       c.MemorySize,
+		Timeout:          c.Timeout,
+		EphemeralStorage: c.EphemeralStorage,
+		Role:             awsV2.ToString(c.Role),
+		State:            string(c.State),
+		LastUpdateStatus: string(c.LastUpdateStatus),
+		VpcConfig:        c.VpcConfig,
+		Layers:           c.Layers,
+		Tags:             fn.Tags,
+	}
+	if fn.Concurrency != nil {
+		desc.ReservedConcurrency = fn.Concurrency.ReservedConcurrentExecutions
+	}
+	if c.Environment != nil {
+		desc.Environment = MaskValues(c.Environment.Variables)
+	}
+	return desc
+}
+
+// MaskValues returns a copy of the given map with all values masked.
+func MaskValues(m map[string]string) map[string]string {
+	masked := make(map[string]string, len(m))
+	for k := range m {
+		masked[k] = MaskedValue
+	}
+	return masked
+}
+
+// lambdaFunctionCtx extracts the session and selected function from the context.
+func lambdaFunctionCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	functionName, ok := ctx.Value(internal.LambdaFunctionName).(string)
+	if !ok || functionName == "" {
+		return cfg, "", fmt.Errorf("failed to get Lambda function name from context")
+	}
+	return cfg, functionName, nil
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaAliases struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *LambdaAliases) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *LambdaAliases) List(ctx context.Context) ([]Object, error) {
+	cfg, functionName, err := lambdaFunctionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := aws.ListLambdaAliases(cfg, functionName)
+	objs := make([]Object, len(aliases))
+	for i, obj := range aliases {
+		objs[i] = obj
+	}
+	return objs, err
+}
+
+func (l *LambdaAliases) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *LambdaAliases) Describe(alias string) (string, error) {
+	cfg, functionName, err := lambdaFunctionCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetLambdaAlias(cfg, functionName, alias)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
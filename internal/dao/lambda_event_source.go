@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaEventSources struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *LambdaEventSources) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *LambdaEventSources) List(ctx context.Context) ([]Object, error) {
+	cfg, functionName, err := lambdaFunctionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := aws.ListLambdaEventSources(cfg, functionName)
+	objs := make([]Object, len(sources))
+	for i, obj := range sources {
+		objs[i] = obj
+	}
+	return objs, err
+}
+
+func (l *LambdaEventSources) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *LambdaEventSources) Describe(uuid string) (string, error) {
+	cfg, _, err := lambdaFunctionCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetLambdaEventSource(cfg, uuid)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
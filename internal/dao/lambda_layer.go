@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaLayers struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *LambdaLayers) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *LambdaLayers) List(ctx context.Context) ([]Object, error) {
+	cfg, functionName, err := lambdaFunctionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	layers, err := aws.ListLambdaLayers(cfg, functionName)
+	objs := make([]Object, len(layers))
+	for i, obj := range layers {
+		objs[i] = obj
+	}
+	return objs, err
+}
+
+func (l *LambdaLayers) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *LambdaLayers) Describe(name string) (string, error) {
+	cfg, functionName, err := lambdaFunctionCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	layers, err := aws.ListLambdaLayers(cfg, functionName)
+	if err != nil {
+		return "", err
+	}
+	for _, layer := range layers {
+		if layer.Name == name {
+			res, err := aws.GetLambdaLayerVersion(cfg, layer.Arn)
+			if err != nil {
+				return "", err
+			}
+			return toJSON(res)
+		}
+	}
+	return "", fmt.Errorf("layer %s is not attached to %s", name, functionName)
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaVersions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (l *LambdaVersions) Init(ctx context.Context) {
+	l.ctx = ctx
+}
+
+func (l *LambdaVersions) List(ctx context.Context) ([]Object, error) {
+	cfg, functionName, err := lambdaFunctionCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := aws.ListLambdaVersions(cfg, functionName)
+	objs := make([]Object, len(versions))
+	for i, obj := range versions {
+		objs[i] = obj
+	}
+	return objs, err
+}
+
+func (l *LambdaVersions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (l *LambdaVersions) Describe(version string) (string, error) {
+	cfg, functionName, err := lambdaFunctionCtx(l.ctx)
+	if err != nil {
+		return "", err
+	}
+	fn, err := aws.GetLambdaFunction(cfg, functionName, version)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(newLambdaDescription(fn))
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type HostedZones struct {
+	Accessor
+	ctx context.Context
+}
+
+func (h *HostedZones) Init(ctx context.Context) {
+	h.ctx = ctx
+}
+
+func (h *HostedZones) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	zones, err := aws.ListHostedZones(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list hosted zones: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(zones))
+	for i, obj := range zones {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (h *HostedZones) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (h *HostedZones) Describe(id string) (string, error) {
+	cfg, ok := h.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetHostedZone(cfg, id)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type RecordSets struct {
+	Accessor
+	ctx context.Context
+}
+
+func (r *RecordSets) Init(ctx context.Context) {
+	r.ctx = ctx
+}
+
+func (r *RecordSets) List(ctx context.Context) ([]Object, error) {
+	cfg, zoneId, err := hostedZoneCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := aws.ListRecordSets(cfg, zoneId)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list record sets: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(records))
+	for i, obj := range records {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (r *RecordSets) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes the record sets of a name along with their health checks.
+func (r *RecordSets) Describe(name string) (string, error) {
+	cfg, zoneId, err := hostedZoneCtx(r.ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := aws.GetRecordSets(cfg, zoneId, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func hostedZoneCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	zoneId, ok := ctx.Value(internal.R53HostedZoneId).(string)
+	if !ok || zoneId == "" {
+		return cfg, "", fmt.Errorf("failed to get hosted zone id from context")
+	}
+	return cfg, zoneId, nil
+}
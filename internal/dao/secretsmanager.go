@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type Secrets struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *Secrets) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Secrets) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	secrets, err := aws.ListSecrets(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list secrets: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(secrets))
+	for i, obj := range secrets {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *Secrets) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (s *Secrets) Describe(name string) (string, error) {
+	cfg, ok := s.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetSecret(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type SecretVersions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *SecretVersions) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *SecretVersions) List(ctx context.Context) ([]Object, error) {
+	cfg, name, err := secretCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := aws.ListSecretVersions(cfg, name)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list secret versions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(versions))
+	for i, obj := range versions {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *SecretVersions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (s *SecretVersions) Describe(versionId string) (string, error) {
+	cfg, name, err := secretCtx(s.ctx)
+	if err != nil {
+		return "", err
+	}
+	versions, err := aws.ListSecretVersions(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range versions {
+		if v.VersionId == versionId {
+			return toJSON(v)
+		}
+	}
+	return "", fmt.Errorf("version %s of secret %s not found", versionId, name)
+}
+
+func secretCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	name, ok := ctx.Value(internal.SecretName).(string)
+	if !ok || name == "" {
+		return cfg, "", fmt.Errorf("failed to get secret name from context")
+	}
+	return cfg, name, nil
+}
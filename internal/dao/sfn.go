@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type StateMachines struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *StateMachines) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *StateMachines) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	machines, err := aws.ListStateMachines(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list state machines: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(machines))
+	for i, obj := range machines {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *StateMachines) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe returns the ASL definition of a state machine given its arn.
+func (s *StateMachines) Describe(arn string) (string, error) {
+	cfg, ok := s.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	return aws.GetStateMachineDefinition(cfg, arn)
+}
+
+type Executions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *Executions) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *Executions) List(ctx context.Context) ([]Object, error) {
+	cfg, arn, err := sfnCtx(ctx, internal.SFNStateMachineArn)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := aws.ListExecutions(cfg, arn)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list executions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(executions))
+	for i, obj := range executions {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *Executions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes an execution given its arn.
+func (e *Executions) Describe(arn string) (string, error) {
+	cfg, ok := e.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetExecution(cfg, arn)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type ExecutionEvents struct {
+	Accessor
+	ctx context.Context
+}
+
+func (e *ExecutionEvents) Init(ctx context.Context) {
+	e.ctx = ctx
+}
+
+func (e *ExecutionEvents) List(ctx context.Context) ([]Object, error) {
+	cfg, arn, err := sfnCtx(ctx, internal.SFNExecutionArn)
+	if err != nil {
+		return nil, err
+	}
+	events, err := aws.ListExecutionEvents(cfg, arn)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list execution history: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(events))
+	for i, obj := range events {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (e *ExecutionEvents) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (e *ExecutionEvents) Describe(id string) (string, error) {
+	cfg, arn, err := sfnCtx(e.ctx, internal.SFNExecutionArn)
+	if err != nil {
+		return "", err
+	}
+	events, err := aws.ListExecutionEvents(cfg, arn)
+	if err != nil {
+		return "", err
+	}
+	for _, ev := range events {
+		if ev.Id == id {
+			return toJSON(ev.Raw)
+		}
+	}
+	return "", fmt.Errorf("event %s of execution %s not found", id, arn)
+}
+
+func sfnCtx(ctx context.Context, key internal.ContextKey) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	arn, ok := ctx.Value(key).(string)
+	if !ok || arn == "" {
+		return cfg, "", fmt.Errorf("failed to get %s from context", key)
+	}
+	return cfg, arn, nil
+}
@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+// Topics lists the SNS topics, only the ones feeding the endpoint in context
+// if any.
+type Topics struct {
+	Accessor
+	ctx context.Context
+}
+
+func (t *Topics) Init(ctx context.Context) {
+	t.ctx = ctx
+}
+
+func (t *Topics) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	endpoint, _ := ctx.Value(internal.SNSEndpoint).(string)
+	topics, err := aws.ListTopics(cfg, endpoint)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list topics: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(topics))
+	for i, obj := range topics {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (t *Topics) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a topic given its arn.
+func (t *Topics) Describe(topicArn string) (string, error) {
+	cfg, ok := t.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetTopicAttributes(cfg, topicArn)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type Subscriptions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (s *Subscriptions) Init(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Subscriptions) List(ctx context.Context) ([]Object, error) {
+	cfg, topicArn, err := topicCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subs, err := aws.ListSubscriptions(cfg, topicArn)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list subscriptions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(subs))
+	for i, obj := range subs {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (s *Subscriptions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a subscription given its id, unconfirmed ones having
+// no attributes.
+func (s *Subscriptions) Describe(id string) (string, error) {
+	cfg, topicArn, err := topicCtx(s.ctx)
+	if err != nil {
+		return "", err
+	}
+	subs, err := aws.ListSubscriptions(cfg, topicArn)
+	if err != nil {
+		return "", err
+	}
+	for _, sub := range subs {
+		if sub.Id != id {
+			continue
+		}
+		if sub.Arn == aws.SnsPendingConfirmation {
+			return toJSON(sub)
+		}
+		res, err := aws.GetSubscriptionAttributes(cfg, sub.Arn)
+		if err != nil {
+			return "", err
+		}
+		return toJSON(res)
+	}
+	return "", fmt.Errorf("subscription %s not found", id)
+}
+
+func topicCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	topicArn, ok := ctx.Value(internal.SNSTopicArn).(string)
+	if !ok || topicArn == "" {
+		return cfg, "", fmt.Errorf("failed to get topic arn from context")
+	}
+	return cfg, topicArn, nil
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
@@ -41,6 +42,13 @@ func (sqs *SQS) Describe(queueUrl string) (string, error) {
 	if !ok {
 		log.Err(fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg))
 	}
-	res, _ := aws.GetMessageFromQueue(cfg, queueUrl)
-	return fmt.Sprintf("%v", res), nil
+	attrs, err := aws.GetQueueAttributes(cfg, queueUrl)
+	if err != nil {
+		return "", err
+	}
+	res, err := json.MarshalIndent(attrs, "", " ")
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
 }
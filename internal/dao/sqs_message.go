@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+type SQSMessages struct {
+	Accessor
+	ctx context.Context
+
+	mx       sync.RWMutex
+	queueUrl string
+	messages []aws.SQSMessageResp
+}
+
+func (m *SQSMessages) Init(ctx context.Context) {
+	m.ctx = ctx
+}
+
+func (m *SQSMessages) List(ctx context.Context) ([]Object, error) {
+	var errMsg string
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		errMsg = fmt.Sprintf("conversion err: Expected awsV2.Config but got %v", cfg)
+		log.Err(fmt.Errorf(errMsg))
+	}
+	queueUrl, ok := ctx.Value(internal.SQSQueueUrl).(string)
+	if !ok || queueUrl == "" {
+		errMsg = "failed to get SQS queue url from context"
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	// Receiving bumps the receive count of messages, so they are only peeked
+	// on demand and listings otherwise show the last peeked ones.
+	if peek, _ := ctx.Value(internal.SQSPeekMessages).(bool); peek || queueUrl != m.queueUrl {
+		msgs, err := aws.PeekMessages(cfg, queueUrl)
+		if err != nil {
+			return nil, err
+		}
+		m.queueUrl, m.messages = queueUrl, msgs
+	}
+	objs := make([]Object, len(m.messages))
+	for i, msg := range m.messages {
+		objs[i] = msg
+	}
+
+	return objs, nil
+}
+
+func (m *SQSMessages) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Message returns the last peeked message matching the given id.
+func (m *SQSMessages) Message(id string) (aws.SQSMessageResp, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	for _, msg := range m.messages {
+		if msg.MessageId == id {
+			return msg, true
+		}
+	}
+	return aws.SQSMessageResp{}, false
+}
+
+// Forget drops peeked messages once deleted, all of them when no id is given.
+func (m *SQSMessages) Forget(ids ...string) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	if len(ids) == 0 {
+		m.messages = nil
+		return
+	}
+	gone := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		gone[id] = true
+	}
+	kept := m.messages[:0]
+	for _, msg := range m.messages {
+		if !gone[msg.MessageId] {
+			kept = append(kept, msg)
+		}
+	}
+	m.messages = kept
+}
+
+func (m *SQSMessages) Describe(messageId string) (string, error) {
+	msg, ok := m.Message(messageId)
+	if !ok {
+		return "", fmt.Errorf("message %s is no longer available", messageId)
+	}
+	desc := struct {
+		aws.SQSMessageResp
+		Body interface{}
+	}{SQSMessageResp: msg, Body: msg.Body}
+	// Pretty print JSON bodies.
+	var body interface{}
+	if err := json.Unmarshal([]byte(msg.Body), &body); err == nil {
+		desc.Body = body
+	}
+	res, err := json.MarshalIndent(desc, "", " ")
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/rs/zerolog/log"
+)
+
+// Parameters lists the SSM parameters of a path, one folder level at a time.
+type Parameters struct {
+	Accessor
+	ctx context.Context
+}
+
+func (p *Parameters) Init(ctx context.Context) {
+	p.ctx = ctx
+}
+
+func (p *Parameters) List(ctx context.Context) ([]Object, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return nil, fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	path, ok := ctx.Value(internal.SSMParameterPath).(string)
+	if !ok || path == "" {
+		path = "/"
+	}
+	params, err := aws.ListParameters(cfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list parameters: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	params = aws.ParametersAt(params, path)
+	objs := make([]Object, len(params))
+	for i, obj := range params {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (p *Parameters) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+// Describe describes a parameter given its full name.
+func (p *Parameters) Describe(name string) (string, error) {
+	cfg, ok := p.ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	res, err := aws.GetParameter(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+type ParameterVersions struct {
+	Accessor
+	ctx context.Context
+}
+
+func (p *ParameterVersions) Init(ctx context.Context) {
+	p.ctx = ctx
+}
+
+func (p *ParameterVersions) List(ctx context.Context) ([]Object, error) {
+	cfg, name, err := parameterCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := aws.ListParameterVersions(cfg, name)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to list parameter versions: %v", err)
+		log.Err(fmt.Errorf(errMsg))
+		return nil, fmt.Errorf(errMsg)
+	}
+	objs := make([]Object, len(versions))
+	for i, obj := range versions {
+		objs[i] = obj
+	}
+	return objs, nil
+}
+
+func (p *ParameterVersions) Get(ctx context.Context, path string) (Object, error) {
+	return nil, nil
+}
+
+func (p *ParameterVersions) Describe(version string) (string, error) {
+	cfg, name, err := parameterCtx(p.ctx)
+	if err != nil {
+		return "", err
+	}
+	versions, err := aws.ListParameterVersions(cfg, name)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			return toJSON(v)
+		}
+	}
+	return "", fmt.Errorf("version %s of parameter %s not found", version, name)
+}
+
+func parameterCtx(ctx context.Context) (awsV2.Config, string, error) {
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return cfg, "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	name, ok := ctx.Value(internal.SSMParameterName).(string)
+	if !ok || name == "" {
+		return cfg, "", fmt.Errorf("failed to get parameter name from context")
+	}
+	return cfg, name, nil
+}
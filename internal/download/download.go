@@ -0,0 +1,302 @@
+package download
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultWorkers is the number of parallel downloads used when none is configured.
+	DefaultWorkers = 4
+
+	partSuffix = ".part"
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Object represents a remote object to be downloaded.
+type Object struct {
+	// Key is the full object key in the bucket.
+	Key string
+	// Path is the destination path relative to the download directory.
+	Path string
+	Size int64
+	// MD5 is the hex encoded md5 of the object content, if known.
+	MD5       string
+	CRC32C    uint32
+	HasCRC32C bool
+	// ModTime is the last modification time of the object, if known.
+	ModTime time.Time
+}
+
+// Source represents a remote store objects can be listed and read from.
+type Source interface {
+	// List returns all objects under the given prefix, recursively.
+	List(ctx context.Context, prefix string) ([]Object, error)
+
+	// Open returns a reader positioned at the given offset of the object.
+	Open(ctx context.Context, o *Object, offset int64) (io.ReadCloser, error)
+}
+
+// Result summarizes a download run.
+type Result struct {
+	Downloaded int
+	Skipped    int
+	Bytes      int64
+	Errors     []error
+}
+
+// ProgressFunc is called each time an object completes.
+type ProgressFunc func(done, total int)
+
+// Downloader copies objects from a source into a local directory.
+type Downloader struct {
+	src        Source
+	dest       string
+	workers    int
+	progressFn ProgressFunc
+}
+
+// NewDownloader returns a new downloader.
+func NewDownloader(src Source, dest string, workers int) *Downloader {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	return &Downloader{
+		src:     src,
+		dest:    dest,
+		workers: workers,
+	}
+}
+
+// SetProgressFn registers a progress callback.
+func (d *Downloader) SetProgressFn(f ProgressFunc) {
+	d.progressFn = f
+}
+
+// Run downloads all given objects using a pool of workers.
+func (d *Downloader) Run(ctx context.Context, objs []Object) Result {
+	var (
+		res  Result
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+		done int
+	)
+	jobs := make(chan Object)
+	for i := 0; i < d.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for o := range jobs {
+				n, skipped, err := d.fetch(ctx, o)
+				mx.Lock()
+				switch {
+				case err != nil:
+					log.Info().Msg(fmt.Sprintf("failed to download %v, err: %v", o.Key, err))
+					res.Errors = append(res.Errors, fmt.Errorf("%s: %w", o.Key, err))
+				case skipped:
+					res.Skipped++
+				default:
+					res.Downloaded++
+					res.Bytes += n
+				}
+				done++
+				if d.progressFn != nil {
+					d.progressFn(done, len(objs))
+				}
+				mx.Unlock()
+			}
+		}()
+	}
+
+	for _, o := range objs {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- o
+	}
+	close(jobs)
+	wg.Wait()
+
+	return res
+}
+
+func (d *Downloader) fetch(ctx context.Context, o Object) (int64, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, false, err
+	}
+	target, err := localPath(d.dest, o.Path)
+	if err != nil {
+		return 0, false, err
+	}
+	if fi, err := os.Stat(target); err == nil && unchanged(target, fi, &o) {
+		return 0, true, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(target), config.DefaultDirMod); err != nil {
+		return 0, false, err
+	}
+
+	part := target + partSuffix
+	var offset int64
+	if fi, err := os.Stat(part); err == nil {
+		offset = fi.Size()
+	}
+	if offset > o.Size {
+		offset = 0
+	}
+	// A part completed by an earlier run is checked without opening the
+	// object, while the listed checksum may not be the one of the content,
+	// i.e the ETag of an encrypted S3 object, so it is fetched again when it
+	// does not match.
+	if offset == o.Size && o.Size > 0 {
+		if verify(part, &o) == nil {
+			return 0, false, finish(part, target, &o)
+		}
+		offset = 0
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if offset == 0 {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(part, flags, config.DefaultFileMod)
+	if err != nil {
+		return 0, false, err
+	}
+
+	r, err := d.src.Open(ctx, &o, offset)
+	if err != nil {
+		f.Close()
+		return 0, false, err
+	}
+	n, err := io.Copy(f, &contextReader{ctx: ctx, r: r})
+	r.Close()
+	if err != nil {
+		f.Close()
+		return n, false, err
+	}
+	if err := f.Close(); err != nil {
+		return n, false, err
+	}
+
+	if err := verify(part, &o); err != nil {
+		os.Remove(part)
+		return n, false, err
+	}
+
+	return n, false, finish(part, target, &o)
+}
+
+// contextReader stops reading once its context is done, so a canceled
+// download stops between reads whatever the source.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// finish moves a verified part to its target, keeping the object
+// modification time.
+func finish(part, target string, o *Object) error {
+	if err := os.Rename(part, target); err != nil {
+		return err
+	}
+	if !o.ModTime.IsZero() {
+		return os.Chtimes(target, o.ModTime, o.ModTime)
+	}
+
+	return nil
+}
+
+// unchanged checks whether a local file is a copy of the object. Files
+// downloaded earlier carry the object modification time, which is trusted
+// along with the size since a checksum, i.e an S3 ETag of an encrypted
+// object, may not be the one of the content.
+func unchanged(path string, fi os.FileInfo, o *Object) bool {
+	if fi.Size() != o.Size {
+		return false
+	}
+	if !o.ModTime.IsZero() {
+		if fi.ModTime().Equal(o.ModTime) {
+			return true
+		}
+		if o.MD5 == "" && !o.HasCRC32C {
+			return false
+		}
+	}
+
+	return verify(path, o) == nil
+}
+
+// localPath resolves an object path within the destination directory.
+func localPath(dest, path string) (string, error) {
+	p := filepath.Join(dest, filepath.FromSlash(path))
+	rel, err := filepath.Rel(dest, p)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object path %q", path)
+	}
+	return p, nil
+}
+
+// verify checks a local file against the object size and checksum.
+func verify(path string, o *Object) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var h hash.Hash
+	switch {
+	case o.MD5 != "":
+		h = md5.New()
+	case o.HasCRC32C:
+		h = crc32.New(crc32cTable)
+	}
+
+	var n int64
+	if h != nil {
+		n, err = io.Copy(h, f)
+	} else {
+		n, err = io.Copy(io.Discard, f)
+	}
+	if err != nil {
+		return err
+	}
+	if n != o.Size {
+		return fmt.Errorf("size mismatch, expected %d bytes but got %d", o.Size, n)
+	}
+
+	switch {
+	case o.MD5 != "":
+		if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, o.MD5) {
+			return errors.New("md5 checksum mismatch")
+		}
+	case o.HasCRC32C:
+		if sum := h.(hash.Hash32).Sum32(); sum != o.CRC32C {
+			return errors.New("crc32c checksum mismatch")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSource struct {
+	mx      sync.Mutex
+	data    map[string][]byte
+	offsets map[string]int64
+	// encrypted clears the md5 of opened objects, as for SSE-KMS objects.
+	encrypted bool
+}
+
+func newFakeSource(data map[string][]byte) *fakeSource {
+	return &fakeSource{data: data, offsets: make(map[string]int64)}
+}
+
+func (f *fakeSource) List(ctx context.Context, prefix string) ([]Object, error) {
+	return nil, nil
+}
+
+func (f *fakeSource) Open(ctx context.Context, o *Object, offset int64) (io.ReadCloser, error) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	b, ok := f.data[o.Key]
+	if !ok {
+		return nil, errors.New("no such key")
+	}
+	f.offsets[o.Key] = offset
+	if f.encrypted {
+		o.MD5 = ""
+	}
+	return io.NopCloser(bytes.NewReader(b[offset:])), nil
+}
+
+func md5Hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownloaderRun(t *testing.T) {
+	dest := t.TempDir()
+	a, b := []byte("hello world"), []byte("nested content")
+	src := newFakeSource(map[string][]byte{"p/a.txt": a, "p/x/b.txt": b})
+	objs := []Object{
+		{Key: "p/a.txt", Path: "a.txt", Size: int64(len(a)), MD5: md5Hex(a)},
+		{Key: "p/x/b.txt", Path: "x/b.txt", Size: int64(len(b)), CRC32C: crc32.Checksum(b, crc32cTable), HasCRC32C: true},
+	}
+
+	var calls int
+	d := NewDownloader(src, dest, 2)
+	d.SetProgressFn(func(done, total int) { calls++ })
+	res := d.Run(context.Background(), objs)
+
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, 2, res.Downloaded)
+	assert.Equal(t, int64(len(a)+len(b)), res.Bytes)
+	assert.Equal(t, 2, calls)
+	got, err := os.ReadFile(filepath.Join(dest, "x", "b.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, b, got)
+
+	res = d.Run(context.Background(), objs)
+	assert.Equal(t, 2, res.Skipped)
+	assert.Equal(t, 0, res.Downloaded)
+}
+
+func TestDownloaderResume(t *testing.T) {
+	dest := t.TempDir()
+	data := []byte("0123456789")
+	src := newFakeSource(map[string][]byte{"k": data})
+	assert.Nil(t, os.WriteFile(filepath.Join(dest, "k"+partSuffix), data[:4], 0600))
+
+	res := NewDownloader(src, dest, 1).Run(context.Background(), []Object{
+		{Key: "k", Path: "k", Size: int64(len(data)), MD5: md5Hex(data)},
+	})
+
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, int64(4), src.offsets["k"])
+	assert.Equal(t, int64(6), res.Bytes)
+	got, err := os.ReadFile(filepath.Join(dest, "k"))
+	assert.Nil(t, err)
+	assert.Equal(t, data, got)
+	_, err = os.Stat(filepath.Join(dest, "k"+partSuffix))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDownloaderCompletePartOfEncryptedObject(t *testing.T) {
+	dest := t.TempDir()
+	data := []byte("encrypted")
+	src := newFakeSource(map[string][]byte{"k": data})
+	src.encrypted = true
+	assert.Nil(t, os.WriteFile(filepath.Join(dest, "k"+partSuffix), data, 0600))
+
+	res := NewDownloader(src, dest, 1).Run(context.Background(), []Object{
+		{Key: "k", Path: "k", Size: int64(len(data)), MD5: md5Hex([]byte("kms etag"))},
+	})
+
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, int64(0), src.offsets["k"])
+	got, err := os.ReadFile(filepath.Join(dest, "k"))
+	assert.Nil(t, err)
+	assert.Equal(t, data, got)
+}
+
+func TestDownloaderCanceled(t *testing.T) {
+	dest := t.TempDir()
+	data := []byte("content")
+	src := newFakeSource(map[string][]byte{"k": data})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := NewDownloader(src, dest, 1).Run(ctx, []Object{{Key: "k", Path: "k", Size: int64(len(data))}})
+
+	assert.Equal(t, 0, res.Downloaded)
+	_, opened := src.offsets["k"]
+	assert.False(t, opened)
+	_, err := os.Stat(filepath.Join(dest, "k"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDownloaderChecksumMismatch(t *testing.T) {
+	dest := t.TempDir()
+	src := newFakeSource(map[string][]byte{"k": []byte("corrupted")})
+
+	res := NewDownloader(src, dest, 1).Run(context.Background(), []Object{
+		{Key: "k", Path: "k", Size: 9, MD5: md5Hex([]byte("original!"))},
+	})
+
+	assert.Len(t, res.Errors, 1)
+	_, err := os.Stat(filepath.Join(dest, "k"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dest, "k"+partSuffix))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDownloaderSkipsUnchanged(t *testing.T) {
+	dest := t.TempDir()
+	data := []byte("encrypted")
+	modTime := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+	src := newFakeSource(map[string][]byte{"k": data})
+	// SSE-KMS ETags look like md5s but are not the one of the content.
+	objs := []Object{{Key: "k", Path: "k", Size: int64(len(data)), MD5: md5Hex([]byte("kms etag")), ModTime: modTime}}
+	assert.Nil(t, os.WriteFile(filepath.Join(dest, "k"), data, 0600))
+	assert.Nil(t, os.Chtimes(filepath.Join(dest, "k"), modTime, modTime))
+
+	res := NewDownloader(src, dest, 1).Run(context.Background(), objs)
+
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, 1, res.Skipped)
+	_, opened := src.offsets["k"]
+	assert.False(t, opened)
+}
+
+func TestDownloaderKeepsModTime(t *testing.T) {
+	dest := t.TempDir()
+	data := []byte("content")
+	modTime := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+	src := newFakeSource(map[string][]byte{"k": data})
+	objs := []Object{{Key: "k", Path: "k", Size: int64(len(data)), ModTime: modTime}}
+
+	d := NewDownloader(src, dest, 1)
+	res := d.Run(context.Background(), objs)
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, 1, res.Downloaded)
+	fi, err := os.Stat(filepath.Join(dest, "k"))
+	assert.Nil(t, err)
+	assert.True(t, modTime.Equal(fi.ModTime()))
+
+	objs[0].ModTime = modTime.Add(time.Hour)
+	res = d.Run(context.Background(), objs)
+	assert.Equal(t, 1, res.Downloaded)
+	assert.Equal(t, 0, res.Skipped)
+}
+
+func TestLocalPath(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		err  bool
+	}{
+		"plain":  {path: "a/b.txt"},
+		"escape": {path: "../b.txt", err: true},
+		"empty":  {path: "", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := localPath("/tmp/dest", u.path)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
@@ -2,21 +2,25 @@ package gcp
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
-	"os"
-	"os/user"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
-	"github.com/atotto/clipboard"
 	"github.com/dustin/go-humanize"
 	"github.com/one2nc/cloudlens/internal"
 	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/one2nc/cloudlens/internal/download"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/api/iterator"
 )
 
+// StorageMaxSignedURLExpiry is the longest validity of a V4 signed URL.
+const StorageMaxSignedURLExpiry = 7 * 24 * time.Hour
+
 func ListBuckets(ctx context.Context) ([]StorageResp, error) {
 	var bucketInfo []StorageResp
 
@@ -119,71 +123,88 @@ func GetInfoAboutBucket(ctx context.Context) ([]StorageObjResp, error) {
 	return objs, nil
 }
 
-func DownloadObject(ctx context.Context, bucketName string, path string, fileName string) string {
-
-	usr, err := user.Current()
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("error in getting the machine's user: %v", err))
-	}
-	dirPath := usr.HomeDir + "/cloudlens/gcp_storage_objects/"
-	err = os.MkdirAll(dirPath, os.ModePerm)
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("error in creating GCP storage Object directory: %v", err))
-		return ""
-	}
+// StorageSource lists and reads objects of a bucket for the downloader.
+type StorageSource struct {
+	client     *storage.Client
+	bucketName string
+}
 
+// NewStorageSource returns a download source for the given bucket.
+// The caller is responsible for closing the source.
+func NewStorageSource(ctx context.Context, bucketName string) (*StorageSource, error) {
 	client, err := storage.NewClient(ctx)
 	if err != nil {
 		log.Info().Msg(fmt.Sprintf("Failed to create client: %v", err))
-		return ""
-	}
-	defer client.Close()
-
-	// Open the Google Cloud Storage object for reading.
-	reader, err := client.Bucket(bucketName).Object(fmt.Sprintf("%v%v", path, fileName)).NewReader(ctx)
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("Failed to open object for reading: %v", err))
-		return ""
+		return nil, err
 	}
-	defer reader.Close()
+	return &StorageSource{client: client, bucketName: bucketName}, nil
+}
 
-	locaFileName := fileName
-	// Create a local file to save the downloaded object.
-	localFilePath := fmt.Sprintf("%v%v", dirPath, locaFileName)
-	localFile, err := os.Create(localFilePath)
-	if err != nil {
-		log.Info().Msg(fmt.Sprintf("Failed to create local file: %v", err))
-		return ""
-	}
-	defer localFile.Close()
+// Close releases the underlying client.
+func (s *StorageSource) Close() error {
+	return s.client.Close()
+}
 
-	// Copy the object content to the local file.
-	if _, err := fmt.Fprint(localFile, reader); err != nil {
-		log.Info().Msg(fmt.Sprintf("Failed to copy object content to local file: %v", err))
-		return ""
+// List returns all objects under prefix, recursively.
+func (s *StorageSource) List(ctx context.Context, prefix string) ([]download.Object, error) {
+	var objs []download.Object
+	it := s.client.Bucket(s.bucketName).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			log.Info().Msg(fmt.Sprintf("Error in listing objects of %v/%v. err: %v", s.bucketName, prefix, err))
+			return nil, err
+		}
+		if strings.HasSuffix(attrs.Name, "/") {
+			continue
+		}
+		o := download.Object{
+			Key:       attrs.Name,
+			Size:      attrs.Size,
+			CRC32C:    attrs.CRC32C,
+			HasCRC32C: true,
+			ModTime:   attrs.Updated,
+		}
+		// Composite objects carry no md5.
+		if len(attrs.MD5) > 0 {
+			o.MD5 = hex.EncodeToString(attrs.MD5)
+		}
+		objs = append(objs, o)
 	}
-	clipboard.WriteAll(localFilePath)
-
-	return fmt.Sprintf("%v with size %d bytes, downloaded and its path copied to the clipboard", fileName, reader.Attrs.Size)
+	return objs, nil
 }
 
-func GetPreSignedUrl(ctx context.Context, bucketName string, path string, fileName string) string {
+// Open returns a reader on the object starting at offset.
+func (s *StorageSource) Open(ctx context.Context, o *download.Object, offset int64) (io.ReadCloser, error) {
+	return s.client.Bucket(s.bucketName).Object(o.Key).NewRangeReader(ctx, offset, -1)
+}
 
+// GetPreSignedUrl signs a GET or PUT request on the given object, valid for expiry.
+func GetPreSignedUrl(ctx context.Context, bucketName, path, fileName, method string, expiry time.Duration) (string, error) {
+	if expiry <= 0 || expiry > StorageMaxSignedURLExpiry {
+		return "", fmt.Errorf("expiry must be between 1s and %v", StorageMaxSignedURLExpiry)
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		return "", fmt.Errorf("unsupported signing method %q", method)
+	}
 	client, err := storage.NewClient(ctx)
 	if err != nil {
 		log.Info().Msg(fmt.Sprintf("Failed to create client: %v", err))
-		return ""
+		return "", err
 	}
 	defer client.Close()
 
-	// Open the Google Cloud Storage object for reading.
 	url, err := client.Bucket(bucketName).SignedURL(fmt.Sprintf("%v%v", path, fileName), &storage.SignedURLOptions{
-		Method:  "GET",
-		Expires: time.Now().Add(time.Hour),
+		Scheme:  storage.SigningSchemeV4,
+		Method:  method,
+		Expires: time.Now().Add(expiry),
 	})
 	if err != nil {
-		log.Info().Msg(fmt.Sprintf("Failed to open object for reading: %v", err))
-		return ""
+		log.Info().Msg(fmt.Sprintf("Failed to sign %v %v/%v%v: %v", method, bucketName, path, fileName, err))
+		return "", err
 	}
-	return url
+	return url, nil
 }
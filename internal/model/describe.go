@@ -54,20 +54,21 @@ func (d *Describe) filterChanged(lines []string) {
 }
 
 func (d *Describe) filter(q string, lines []string) fuzzy.Matches {
+	return filterLines(q, lines)
+}
+
+// filterLines matches lines against a fuzzy or regex query.
+func filterLines(q string, lines []string) fuzzy.Matches {
 	if q == "" {
 		return nil
 	}
 	if dao.IsFuzzySelector(q) {
-		return d.fuzzyFilter(strings.TrimSpace(q[2:]), lines)
+		return fuzzy.Find(strings.TrimSpace(q[2:]), lines)
 	}
-	return d.rxFilter(q, lines)
-}
-
-func (*Describe) fuzzyFilter(q string, lines []string) fuzzy.Matches {
-	return fuzzy.Find(q, lines)
+	return rxFilter(q, lines)
 }
 
-func (*Describe) rxFilter(q string, lines []string) fuzzy.Matches {
+func rxFilter(q string, lines []string) fuzzy.Matches {
 	rx, err := regexp.Compile(`(?i)` + q)
 	if err != nil {
 		return nil
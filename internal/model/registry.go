@@ -71,6 +71,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.SQS{},
 		Renderer: &render.SQS{},
 	},
+	internal.LowercaseSQSMessages: {
+		DAO:      &dao.SQSMessages{},
+		Renderer: &render.SQSMessages{},
+	},
 	internal.LowercaseVPC: {
 		DAO:      &dao.VPC{},
 		Renderer: &render.VPC{},
@@ -83,6 +87,22 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Lambda{},
 		Renderer: &render.Lambda{},
 	},
+	internal.LowercaseLambdaVersions: {
+		DAO:      &dao.LambdaVersions{},
+		Renderer: &render.LambdaVersions{},
+	},
+	internal.LowercaseLambdaAliases: {
+		DAO:      &dao.LambdaAliases{},
+		Renderer: &render.LambdaAliases{},
+	},
+	internal.LowercaseLambdaEventSources: {
+		DAO:      &dao.LambdaEventSources{},
+		Renderer: &render.LambdaEventSources{},
+	},
+	internal.LowercaseLambdaLayers: {
+		DAO:      &dao.LambdaLayers{},
+		Renderer: &render.LambdaLayers{},
+	},
 	internal.LowercaseEcsCluster: {
 		DAO:      &dao.ECSClusters{},
 		Renderer: &render.EcsClusters{},
@@ -99,6 +119,190 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.ECSContainers{},
 		Renderer: &render.EcsContainers{},
 	},
+	internal.LowercaseEcsDeployments: {
+		DAO:      &dao.ECSDeployments{},
+		Renderer: &render.EcsDeployments{},
+	},
+	internal.LowercaseEcsTaskDefinitions: {
+		DAO:      &dao.ECSTaskDefinitionFamilies{},
+		Renderer: &render.EcsTaskDefinitionFamilies{},
+	},
+	internal.LowercaseEcsTaskDefinitionRevisions: {
+		DAO:      &dao.ECSTaskDefinitions{},
+		Renderer: &render.EcsTaskDefinitions{},
+	},
+	internal.LowercaseEks: {
+		DAO:      &dao.EKSClusters{},
+		Renderer: &render.EksClusters{},
+	},
+	internal.LowercaseEksNodeGroups: {
+		DAO:      &dao.EKSNodeGroups{},
+		Renderer: &render.EksNodeGroups{},
+	},
+	internal.LowercaseEcr: {
+		DAO:      &dao.ECRRepositories{},
+		Renderer: &render.EcrRepositories{},
+	},
+	internal.LowercaseEcrImages: {
+		DAO:      &dao.ECRImages{},
+		Renderer: &render.EcrImages{},
+	},
+	internal.LowercaseElb: {
+		DAO:      &dao.LoadBalancers{},
+		Renderer: &render.LoadBalancers{},
+	},
+	internal.LowercaseElbRules: {
+		DAO:      &dao.ElbRules{},
+		Renderer: &render.ElbRules{},
+	},
+	internal.LowercaseElbTargets: {
+		DAO:      &dao.ElbTargets{},
+		Renderer: &render.ElbTargets{},
+	},
+	internal.LowercaseAsg: {
+		DAO:      &dao.AutoScalingGroups{},
+		Renderer: &render.AutoScalingGroups{},
+	},
+	internal.LowercaseAsgInstances: {
+		DAO:      &dao.AsgInstances{},
+		Renderer: &render.AsgInstances{},
+	},
+	internal.LowercaseAsgActivities: {
+		DAO:      &dao.AsgActivities{},
+		Renderer: &render.AsgActivities{},
+	},
+	internal.LowercaseR53: {
+		DAO:      &dao.HostedZones{},
+		Renderer: &render.HostedZones{},
+	},
+	internal.LowercaseR53Records: {
+		DAO:      &dao.RecordSets{},
+		Renderer: &render.RecordSets{},
+	},
+	internal.LowercaseCfn: {
+		DAO:      &dao.Stacks{},
+		Renderer: &render.Stacks{},
+	},
+	internal.LowercaseCfnResources: {
+		DAO:      &dao.StackResources{},
+		Renderer: &render.StackResources{},
+	},
+	internal.LowercaseCfnEvents: {
+		DAO:      &dao.StackEvents{},
+		Renderer: &render.StackEvents{},
+	},
+	internal.LowercaseSecrets: {
+		DAO:      &dao.Secrets{},
+		Renderer: &render.Secrets{},
+	},
+	internal.LowercaseSecretVersions: {
+		DAO:      &dao.SecretVersions{},
+		Renderer: &render.SecretVersions{},
+	},
+	internal.LowercaseSsmParameters: {
+		DAO:      &dao.Parameters{},
+		Renderer: &render.Parameters{},
+	},
+	internal.LowercaseSsmParameterVersions: {
+		DAO:      &dao.ParameterVersions{},
+		Renderer: &render.ParameterVersions{},
+	},
+	internal.LowercaseKms: {
+		DAO:      &dao.KmsKeys{},
+		Renderer: &render.KmsKeys{},
+	},
+	internal.LowercaseAcm: {
+		DAO:      &dao.Certificates{},
+		Renderer: &render.Certificates{},
+	},
+	internal.LowercaseSns: {
+		DAO:      &dao.Topics{},
+		Renderer: &render.Topics{},
+	},
+	internal.LowercaseSnsSubscriptions: {
+		DAO:      &dao.Subscriptions{},
+		Renderer: &render.Subscriptions{},
+	},
+	internal.LowercaseEvents: {
+		DAO:      &dao.EventBuses{},
+		Renderer: &render.EventBuses{},
+	},
+	internal.LowercaseEventRules: {
+		DAO:      &dao.EventRules{},
+		Renderer: &render.EventRules{},
+	},
+	internal.LowercaseEventTargets: {
+		DAO:      &dao.EventTargets{},
+		Renderer: &render.EventTargets{},
+	},
+	internal.LowercaseSchedules: {
+		DAO:      &dao.Schedules{},
+		Renderer: &render.Schedules{},
+	},
+	internal.LowercaseSfn: {
+		DAO:      &dao.StateMachines{},
+		Renderer: &render.StateMachines{},
+	},
+	internal.LowercaseSfnExecutions: {
+		DAO:      &dao.Executions{},
+		Renderer: &render.Executions{},
+	},
+	internal.LowercaseSfnHistory: {
+		DAO:      &dao.ExecutionEvents{},
+		Renderer: &render.ExecutionEvents{},
+	},
+	internal.LowercaseKinesis: {
+		DAO:      &dao.KinesisStreams{},
+		Renderer: &render.KinesisStreams{},
+	},
+	internal.LowercaseKinesisShards: {
+		DAO:      &dao.Shards{},
+		Renderer: &render.Shards{},
+	},
+	internal.LowercaseFirehose: {
+		DAO:      &dao.DeliveryStreams{},
+		Renderer: &render.DeliveryStreams{},
+	},
+	internal.LowercaseCache: {
+		DAO:      &dao.Caches{},
+		Renderer: &render.Caches{},
+	},
+	internal.LowercaseCacheNodes: {
+		DAO:      &dao.CacheNodes{},
+		Renderer: &render.CacheNodes{},
+	},
+	internal.LowercaseApigw: {
+		DAO:      &dao.Apis{},
+		Renderer: &render.Apis{},
+	},
+	internal.LowercaseApiStages: {
+		DAO:      &dao.ApiStages{},
+		Renderer: &render.ApiStages{},
+	},
+	internal.LowercaseApiRoutes: {
+		DAO:      &dao.ApiRoutes{},
+		Renderer: &render.ApiRoutes{},
+	},
+	internal.LowercaseCf: {
+		DAO:      &dao.Distributions{},
+		Renderer: &render.Distributions{},
+	},
+	internal.LowercaseCfBehaviors: {
+		DAO:      &dao.CacheBehaviors{},
+		Renderer: &render.CacheBehaviors{},
+	},
+	internal.LowercaseEfs: {
+		DAO:      &dao.FileSystems{},
+		Renderer: &render.FileSystems{},
+	},
+	internal.LowercaseEfsMounts: {
+		DAO:      &dao.MountTargets{},
+		Renderer: &render.MountTargets{},
+	},
+	internal.LowercaseEfsAccess: {
+		DAO:      &dao.AccessPoints{},
+		Renderer: &render.AccessPoints{},
+	},
 	internal.LowercaseStorage: {
 		DAO:      &dao.Storage{},
 		Renderer: &render.Storage{},
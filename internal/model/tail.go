@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxTailLines caps the number of lines kept by a tail.
+const maxTailLines = 5000
+
+// TailReadFn reads the lines following the ones previously read.
+type TailReadFn func(ctx context.Context) ([]string, error)
+
+// Tail represents a text model appending the lines read on each refresh,
+// following the source while watched.
+type Tail struct {
+	path        string
+	query       string
+	lines       []string
+	read        TailReadFn
+	refreshRate time.Duration
+	listeners   []ResourceViewerListener
+	mx          sync.Mutex
+}
+
+// NewTail returns a new tail model.
+func NewTail(path string, read TailReadFn) *Tail {
+	return &Tail{
+		path:        path,
+		read:        read,
+		refreshRate: defaultReaderRefreshRate,
+	}
+}
+
+// GetPath returns the active resource path.
+func (t *Tail) GetPath() string {
+	return t.path
+}
+
+// SetOptions toggle model options.
+func (t *Tail) SetOptions(context.Context, ViewerToggleOpts) {}
+
+// Filter filters the model.
+func (t *Tail) Filter(q string) {
+	t.mx.Lock()
+	t.query = q
+	t.mx.Unlock()
+	t.fireResourceChanged()
+}
+
+// ClearFilter clear out the filter.
+func (t *Tail) ClearFilter() {
+	t.Filter("")
+}
+
+// Peek returns current model state.
+func (t *Tail) Peek() []string {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	return t.lines
+}
+
+// Refresh reads the next lines once, in the background since reads may poll
+// the source.
+func (t *Tail) Refresh(ctx context.Context) error {
+	go t.refresh(ctx)
+	return nil
+}
+
+// Watch reads the next lines until the context is canceled.
+func (t *Tail) Watch(ctx context.Context) error {
+	go t.updater(ctx)
+	return nil
+}
+
+func (t *Tail) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Tail canceled -- %q", t.path)
+
+	for {
+		if err := t.refresh(ctx); err != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.refreshRate):
+		}
+	}
+}
+
+func (t *Tail) refresh(ctx context.Context) error {
+	lines, err := t.read(ctx)
+	if err != nil {
+		log.Error().Err(err).Msgf("tail failed %q", t.path)
+		t.fireResourceFailed(err)
+		return err
+	}
+	if len(lines) == 0 && len(t.Peek()) > 0 {
+		return nil
+	}
+	t.mx.Lock()
+	t.lines = append(t.lines, lines...)
+	if n := len(t.lines) - maxTailLines; n > 0 {
+		t.lines = t.lines[n:]
+	}
+	t.mx.Unlock()
+	t.fireResourceChanged()
+
+	return nil
+}
+
+// AddListener adds a new model listener.
+func (t *Tail) AddListener(l ResourceViewerListener) {
+	t.listeners = append(t.listeners, l)
+}
+
+// RemoveListener delete a listener from the list.
+func (t *Tail) RemoveListener(l ResourceViewerListener) {
+	for i, lis := range t.listeners {
+		if lis == l {
+			t.listeners = append(t.listeners[:i], t.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *Tail) fireResourceChanged() {
+	t.mx.Lock()
+	lines, matches := t.lines, filterLines(t.query, t.lines)
+	t.mx.Unlock()
+	for _, l := range t.listeners {
+		l.ResourceChanged(lines, matches)
+	}
+}
+
+func (t *Tail) fireResourceFailed(err error) {
+	for _, l := range t.listeners {
+		l.ResourceFailed(err)
+	}
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"strings"
+)
+
+// Text represents a static text model.
+type Text struct {
+	path      string
+	query     string
+	lines     []string
+	listeners []ResourceViewerListener
+}
+
+// NewText returns a new text model.
+func NewText(path, text string) *Text {
+	return &Text{
+		path:  path,
+		lines: strings.Split(text, "\n"),
+	}
+}
+
+// GetPath returns the active resource path.
+func (t *Text) GetPath() string {
+	return t.path
+}
+
+// SetOptions toggle model options.
+func (t *Text) SetOptions(context.Context, ViewerToggleOpts) {}
+
+// Filter filters the model.
+func (t *Text) Filter(q string) {
+	t.query = q
+	t.fireResourceChanged()
+}
+
+// ClearFilter clear out the filter.
+func (t *Text) ClearFilter() {
+	t.query = ""
+	t.fireResourceChanged()
+}
+
+// Peek returns current model state.
+func (t *Text) Peek() []string {
+	return t.lines
+}
+
+// Refresh updates model data.
+func (t *Text) Refresh(context.Context) error {
+	t.fireResourceChanged()
+	return nil
+}
+
+// Watch notifies listeners of the model content.
+func (t *Text) Watch(context.Context) error {
+	t.fireResourceChanged()
+	return nil
+}
+
+// AddListener adds a new model listener.
+func (t *Text) AddListener(l ResourceViewerListener) {
+	t.listeners = append(t.listeners, l)
+}
+
+// RemoveListener delete a listener from the list.
+func (t *Text) RemoveListener(l ResourceViewerListener) {
+	for i, lis := range t.listeners {
+		if lis == l {
+			t.listeners = append(t.listeners[:i], t.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *Text) fireResourceChanged() {
+	matches := filterLines(t.query, t.lines)
+	for _, l := range t.listeners {
+		l.ResourceChanged(t.lines, matches)
+	}
+}
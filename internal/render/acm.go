@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type Certificates struct {
+}
+
+func (c Certificates) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Domain", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "SANs", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Type", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "In-Use-By", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Not-After", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Days-Left", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (c Certificates) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.CertificateResp)
+	if !ok {
+		return fmt.Errorf("expected CertificateResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Domain,
+		resp.SANs,
+		resp.Status,
+		resp.Type,
+		resp.InUseBy,
+		resp.NotAfter,
+		resp.DaysLeft,
+	}
+	return nil
+}
+
+// ColorerFunc colors expired certificates and the ones expiring within days.
+func (c Certificates) ColorerFunc(days int) ColorerFunc {
+	return func(h Header, re RowEvent) tcell.Color {
+		left, ok := certDaysLeft(h, re)
+		switch {
+		case !ok:
+			return tcell.ColorDefault
+		case left < 0:
+			return tcell.ColorRed
+		case left <= days:
+			return tcell.ColorYellow
+		default:
+			return tcell.ColorDefault
+		}
+	}
+}
+
+// ExpiringCertificates counts the certificates expired or expiring within days.
+func ExpiringCertificates(data *TableData, days int) int {
+	var count int
+	for _, re := range data.RowEvents {
+		if left, ok := certDaysLeft(data.Header, re); ok && left <= days {
+			count++
+		}
+	}
+	return count
+}
+
+func certDaysLeft(h Header, re RowEvent) (int, bool) {
+	idx := h.IndexOf("Days-Left", false)
+	if idx < 0 || idx >= len(re.Row.Fields) {
+		return 0, false
+	}
+	left, err := strconv.Atoi(re.Row.Fields[idx])
+	if err != nil {
+		return 0, false
+	}
+	return left, true
+}
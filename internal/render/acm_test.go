@@ -0,0 +1,38 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificatesRender(t *testing.T) {
+	resp := aws.CertificateResp{Id: "1234", Domain: "example.com", SANs: "example.com,*.example.com", Status: "ISSUED", Type: "AMAZON_ISSUED", InUseBy: "loadbalancer/app/web/1", NotAfter: "Mon Jan  2 10:00:00 2023", DaysLeft: "12"}
+	var c Certificates
+
+	r := NewRow(8)
+	err := c.Render(resp, "acm", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"1234", "example.com", "example.com,*.example.com", "ISSUED", "AMAZON_ISSUED", "loadbalancer/app/web/1", "Mon Jan  2 10:00:00 2023", "12"}, r.Fields[0:])
+}
+
+func TestCertificatesColorer(t *testing.T) {
+	var c Certificates
+	h := c.Header()
+	row := func(days string) RowEvent {
+		return RowEvent{Row: Row{Fields: Fields{"1", "example.com", "", "ISSUED", "", "", "", days}}}
+	}
+	colorer := c.ColorerFunc(30)
+
+	assert.Equal(t, tcell.ColorRed, colorer(h, row("-2")))
+	assert.Equal(t, tcell.ColorYellow, colorer(h, row("30")))
+	assert.Equal(t, tcell.ColorDefault, colorer(h, row("31")))
+	assert.Equal(t, tcell.ColorDefault, colorer(h, row("")))
+
+	data := TableData{Header: h, RowEvents: RowEvents{row("-2"), row("10"), row("90"), row("")}}
+	assert.Equal(t, 2, ExpiringCertificates(&data, 30))
+	assert.Equal(t, 3, ExpiringCertificates(&data, 90))
+}
@@ -0,0 +1,102 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type Apis struct {
+}
+
+func (a Apis) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Protocol", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Endpoint-Type", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Description", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (a Apis) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ApiResp)
+	if !ok {
+		return fmt.Errorf("expected ApiResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Name,
+		resp.Protocol,
+		resp.EndpointType,
+		resp.Created,
+		resp.Description,
+	}
+	return nil
+}
+
+type ApiStages struct {
+}
+
+func (a ApiStages) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Stage", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Deployment", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Throttling", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Logging", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Access-Log", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Updated", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Invoke-URL", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (a ApiStages) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ApiStageResp)
+	if !ok {
+		return fmt.Errorf("expected ApiStageResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Stage,
+		resp.Deployment,
+		resp.Throttling,
+		resp.Logging,
+		resp.AccessLog,
+		resp.Updated,
+		resp.InvokeUrl,
+	}
+	return nil
+}
+
+type ApiRoutes struct {
+}
+
+func (a ApiRoutes) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Route", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Integration", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Target", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Authorization", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (a ApiRoutes) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ApiRouteResp)
+	if !ok {
+		return fmt.Errorf("expected ApiRouteResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Route,
+		resp.Integration,
+		resp.Target,
+		resp.Authorization,
+	}
+	return nil
+}
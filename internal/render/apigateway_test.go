@@ -0,0 +1,41 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApisRender(t *testing.T) {
+	resp := aws.ApiResp{Id: "a1b2c3", Name: "orders", Protocol: "HTTP", EndpointType: "REGIONAL", Created: "Mon Jan  2 15:04:05 2023", Description: "Orders API"}
+	var a Apis
+
+	r := NewRow(6)
+	err := a.Render(resp, "apigw", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"a1b2c3", "orders", "HTTP", "REGIONAL", "Mon Jan  2 15:04:05 2023", "Orders API"}, r.Fields[0:])
+}
+
+func TestApiStagesRender(t *testing.T) {
+	resp := aws.ApiStageResp{Stage: "prod", Deployment: "d1e2f3", Throttling: "100/s burst 50", Logging: "INFO", AccessLog: "/aws/apigw/orders", Updated: "Mon Jan  2 15:04:05 2023", InvokeUrl: "https://a1b2c3.execute-api.us-east-1.amazonaws.com/prod"}
+	var a ApiStages
+
+	r := NewRow(7)
+	err := a.Render(resp, "apigw:s", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"prod", "d1e2f3", "100/s burst 50", "INFO", "/aws/apigw/orders", "Mon Jan  2 15:04:05 2023", "https://a1b2c3.execute-api.us-east-1.amazonaws.com/prod"}, r.Fields[0:])
+}
+
+func TestApiRoutesRender(t *testing.T) {
+	resp := aws.ApiRouteResp{Route: "GET /orders", Integration: "AWS_PROXY", Target: "lambda:list-orders", Authorization: "JWT"}
+	var a ApiRoutes
+
+	r := NewRow(4)
+	err := a.Render(resp, "apigw:r", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"GET /orders", "AWS_PROXY", "lambda:list-orders", "JWT"}, r.Fields[0:])
+}
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type AutoScalingGroups struct {
+}
+
+func (a AutoScalingGroups) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Min", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Desired", SortIndicatorIdx: 0, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Max", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Instances", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Launch-Template", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Health-Check", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Suspended", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (a AutoScalingGroups) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.AsgResp)
+	if !ok {
+		return fmt.Errorf("expected AsgResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Min,
+		resp.Desired,
+		resp.Max,
+		resp.Instances,
+		resp.LaunchTemplate,
+		resp.HealthCheckType,
+		resp.SuspendedProcesses,
+		resp.CreatedAt,
+		resp.Arn,
+	}
+	return nil
+}
+
+// AsgInstances renders the member instances of an auto scaling group
+// using the EC2 columns.
+type AsgInstances struct {
+	EC2
+}
+
+func (a AsgInstances) Header() Header {
+	return append(a.EC2.Header(),
+		HeaderColumn{Name: "Lifecycle-State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Health-Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	)
+}
+
+func (a AsgInstances) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.AsgInstanceResp)
+	if !ok {
+		return fmt.Errorf("expected AsgInstanceResp, but got %T", o)
+	}
+	if err := a.EC2.Render(resp.EC2Resp, ns, row); err != nil {
+		return err
+	}
+	row.Fields = append(row.Fields, resp.LifecycleState, resp.HealthStatus)
+	return nil
+}
+
+type AsgActivities struct {
+}
+
+func (a AsgActivities) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Activity-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Start-Time", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "End-Time", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Description", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status-Message", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Cause", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (a AsgActivities) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.AsgActivityResp)
+	if !ok {
+		return fmt.Errorf("expected AsgActivityResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Status,
+		resp.StartTime,
+		resp.EndTime,
+		resp.Description,
+		resp.StatusMessage,
+		resp.Cause,
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoScalingGroupsRender(t *testing.T) {
+	resp := aws.AsgResp{Name: "web", Min: "1", Desired: "2", Max: "4", Instances: "2", LaunchTemplate: "web:$Latest", HealthCheckType: "ELB", Arn: "arn"}
+	var a AutoScalingGroups
+
+	r := NewRow(10)
+	err := a.Render(resp, "asg", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"web", "1", "2", "4", "2", "web:$Latest", "ELB", "", "", "arn"}, r.Fields[0:])
+}
+
+func TestAsgInstancesRender(t *testing.T) {
+	resp := aws.AsgInstanceResp{
+		EC2Resp:        aws.EC2Resp{InstanceId: "i-1", Name: "web", InstanceState: "running", InstanceType: "t3.micro", MonitoringState: "disabled", AvailabilityZone: "us-east-1a"},
+		LifecycleState: "InService",
+		HealthStatus:   "Healthy",
+	}
+	var a AsgInstances
+
+	r := NewRow(10)
+	err := a.Render(resp, "asg:i", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"i-1", "web", "running", "t3.micro", "disabled", "", "", "us-east-1a", "InService", "Healthy"}, r.Fields[0:])
+	assert.Equal(t, len(r.Fields), len(a.Header()))
+	assert.Equal(t, 8, a.Header().IndexOf("Lifecycle-State", false))
+}
@@ -0,0 +1,133 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type Stacks struct {
+}
+
+func (s Stacks) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Drift", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Updated", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Description", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (s Stacks) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.StackResp)
+	if !ok {
+		return fmt.Errorf("expected StackResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Status,
+		resp.Drift,
+		resp.CreatedAt,
+		resp.UpdatedAt,
+		resp.Description,
+	}
+	return nil
+}
+
+type StackResources struct {
+}
+
+func (s StackResources) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Logical-Id", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Physical-Id", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Type", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Drift", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Updated", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Reason", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (s StackResources) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.StackResourceResp)
+	if !ok {
+		return fmt.Errorf("expected StackResourceResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.LogicalId,
+		resp.PhysicalId,
+		resp.Type,
+		resp.Status,
+		resp.Drift,
+		resp.UpdatedAt,
+		resp.Reason,
+	}
+	return nil
+}
+
+type StackEvents struct {
+}
+
+func (s StackEvents) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Timestamp", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Logical-Id", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Type", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Reason", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (s StackEvents) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.StackEventResp)
+	if !ok {
+		return fmt.Errorf("expected StackEventResp, but got %T", o)
+	}
+
+	row.ID = resp.Id
+	row.Fields = Fields{
+		resp.Timestamp,
+		resp.LogicalId,
+		resp.Type,
+		resp.Status,
+		resp.Reason,
+	}
+	return nil
+}
+
+// ColorerFunc colors stack events by status.
+func (s StackEvents) ColorerFunc() ColorerFunc {
+	return func(h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("Status", false)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return tcell.ColorDefault
+		}
+		return stackStatusColor(re.Row.Fields[idx])
+	}
+}
+
+// stackStatusColor returns the color of a stack or resource status.
+func stackStatusColor(status string) tcell.Color {
+	switch {
+	case strings.HasSuffix(status, "_FAILED"), strings.Contains(status, "ROLLBACK"):
+		return tcell.ColorRed
+	case strings.HasSuffix(status, "_IN_PROGRESS"):
+		return tcell.ColorYellow
+	case strings.HasPrefix(status, "DELETE_"), strings.HasSuffix(status, "_SKIPPED"):
+		return tcell.ColorGray
+	case strings.HasSuffix(status, "_COMPLETE"):
+		return tcell.ColorGreen
+	default:
+		return tcell.ColorDefault
+	}
+}
@@ -0,0 +1,61 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStacksRender(t *testing.T) {
+	resp := aws.StackResp{Name: "web", Status: "UPDATE_COMPLETE", Drift: "DRIFTED", CreatedAt: "2023-01-02 10:00:00", UpdatedAt: "2023-02-03 11:00:00", Description: "web tier"}
+	var s Stacks
+
+	r := NewRow(6)
+	err := s.Render(resp, "cfn", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"web", "UPDATE_COMPLETE", "DRIFTED", "2023-01-02 10:00:00", "2023-02-03 11:00:00", "web tier"}, r.Fields[0:])
+}
+
+func TestStackResourcesRender(t *testing.T) {
+	resp := aws.StackResourceResp{LogicalId: "Queue", PhysicalId: "https://sqs.us-east-1.amazonaws.com/123/jobs", Type: "AWS::SQS::Queue", Status: "CREATE_COMPLETE", Drift: "IN_SYNC", UpdatedAt: "2023-01-02 10:00:00"}
+	var s StackResources
+
+	r := NewRow(7)
+	err := s.Render(resp, "cfn:r", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"Queue", "https://sqs.us-east-1.amazonaws.com/123/jobs", "AWS::SQS::Queue", "CREATE_COMPLETE", "IN_SYNC", "2023-01-02 10:00:00", ""}, r.Fields[0:])
+}
+
+func TestStackEventsRender(t *testing.T) {
+	resp := aws.StackEventResp{Id: "e-1", Timestamp: "2023-01-02 10:00:00", LogicalId: "web", Type: "AWS::CloudFormation::Stack", Status: "UPDATE_FAILED", Reason: "boom"}
+	var s StackEvents
+
+	r := NewRow(5)
+	err := s.Render(resp, "cfn:e", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "e-1", r.ID)
+	assert.Equal(t, Fields{"2023-01-02 10:00:00", "web", "AWS::CloudFormation::Stack", "UPDATE_FAILED", "boom"}, r.Fields[0:])
+	assert.Equal(t, tcell.ColorRed, s.ColorerFunc()(s.Header(), RowEvent{Row: r}))
+}
+
+func TestStackStatusColor(t *testing.T) {
+	uu := map[string]tcell.Color{
+		"CREATE_COMPLETE":             tcell.ColorGreen,
+		"UPDATE_IN_PROGRESS":          tcell.ColorYellow,
+		"CREATE_FAILED":               tcell.ColorRed,
+		"UPDATE_ROLLBACK_IN_PROGRESS": tcell.ColorRed,
+		"DELETE_COMPLETE":             tcell.ColorGray,
+		"DELETE_SKIPPED":              tcell.ColorGray,
+		"REVIEW_IN_PROGRESS":          tcell.ColorYellow,
+		"":                            tcell.ColorDefault,
+	}
+
+	for status, color := range uu {
+		assert.Equal(t, color, stackStatusColor(status), status)
+	}
+}
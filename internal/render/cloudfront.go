@@ -0,0 +1,76 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type Distributions struct {
+}
+
+func (d Distributions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Domain", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Aliases", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Price-Class", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Origins", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Comment", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (d Distributions) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.DistributionResp)
+	if !ok {
+		return fmt.Errorf("expected DistributionResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Domain,
+		resp.Aliases,
+		resp.Status,
+		resp.PriceClass,
+		resp.Origins,
+		resp.Comment,
+	}
+	return nil
+}
+
+type CacheBehaviors struct {
+}
+
+func (c CacheBehaviors) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Path-Pattern", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Precedence", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Origin", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Viewer-Protocol", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Methods", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Cache-Policy", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Compress", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (c CacheBehaviors) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.CacheBehaviorResp)
+	if !ok {
+		return fmt.Errorf("expected CacheBehaviorResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.PathPattern,
+		resp.Precedence,
+		resp.Origin,
+		resp.ViewerProtocol,
+		resp.Methods,
+		resp.CachePolicy,
+		resp.Compress,
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributionsRender(t *testing.T) {
+	resp := aws.DistributionResp{Id: "E2QWRUHAPOMQZL", Domain: "d111111abcdef8.cloudfront.net", Aliases: "www.example.com", Status: "Deployed", PriceClass: "PriceClass_100", Origins: "assets.s3.amazonaws.com", Comment: "website"}
+	var d Distributions
+
+	r := NewRow(7)
+	err := d.Render(resp, "cf", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"E2QWRUHAPOMQZL", "d111111abcdef8.cloudfront.net", "www.example.com", "Deployed", "PriceClass_100", "assets.s3.amazonaws.com", "website"}, r.Fields[0:])
+}
+
+func TestCacheBehaviorsRender(t *testing.T) {
+	resp := aws.CacheBehaviorResp{PathPattern: "/images/*", Precedence: "0", Origin: "assets", ViewerProtocol: "redirect-to-https", Methods: "GET,HEAD", CachePolicy: "legacy", Compress: "true"}
+	var c CacheBehaviors
+
+	r := NewRow(7)
+	err := c.Render(resp, "cf:b", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"/images/*", "0", "assets", "redirect-to-https", "GET,HEAD", "legacy", "true"}, r.Fields[0:])
+}
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type EcrRepositories struct {
+}
+
+func (e EcrRepositories) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "URI", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Scan-On-Push", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Tag-Mutability", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Images", SortIndicatorIdx: 0, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (e EcrRepositories) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.EcrRepositoryResp)
+	if !ok {
+		return fmt.Errorf("expected EcrRepositoryResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Uri,
+		resp.ScanOnPush,
+		resp.TagMutability,
+		resp.ImageCount,
+		resp.CreatedAt,
+		resp.Arn,
+	}
+	return nil
+}
+
+type EcrImages struct {
+}
+
+func (e EcrImages) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Digest", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Tags", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Pushed-At", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Size", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Scan-Status", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Findings", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (e EcrImages) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.EcrImageResp)
+	if !ok {
+		return fmt.Errorf("expected EcrImageResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Digest,
+		resp.Tags,
+		resp.PushedAt,
+		resp.Size,
+		resp.ScanStatus,
+		resp.Findings,
+	}
+	return nil
+}
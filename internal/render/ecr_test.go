@@ -0,0 +1,20 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcrImagesRender(t *testing.T) {
+	resp := aws.EcrImageResp{Digest: "sha256:abc", Tags: "v2,latest", PushedAt: "9:00:00", Size: "52 MB", ScanStatus: "COMPLETE", Findings: "HIGH=2"}
+	var e EcrImages
+
+	r := NewRow(6)
+	err := e.Render(resp, "ecr:i", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"sha256:abc", "v2,latest", "9:00:00", "52 MB", "COMPLETE", "HIGH=2"}, r.Fields[0:])
+	assert.Equal(t, 5, e.Header().IndexOf("Findings", false))
+}
@@ -0,0 +1,52 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type EcsDeployments struct {
+}
+
+func (ecs EcsDeployments) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Rollout-State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Task-Definition", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Desired", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Running", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Pending", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Failed", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Circuit-Breaker", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Updated", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: true},
+		HeaderColumn{Name: "Reason", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (ecs EcsDeployments) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.EcsDeploymentResp)
+	if !ok {
+		return fmt.Errorf("expected EcsDeploymentResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Status,
+		resp.RolloutState,
+		resp.TaskDefinition,
+		fmt.Sprint(resp.DesiredCount),
+		fmt.Sprint(resp.RunningCount),
+		fmt.Sprint(resp.PendingCount),
+		fmt.Sprint(resp.FailedTasks),
+		resp.CircuitBreaker,
+		resp.CreatedAt,
+		resp.UpdatedAt,
+		resp.RolloutStateReason,
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcsDeploymentsRender(t *testing.T) {
+	resp := aws.EcsDeploymentResp{
+		Id:             "ecs-svc/123",
+		Status:         "PRIMARY",
+		RolloutState:   "IN_PROGRESS",
+		TaskDefinition: "web:42",
+		DesiredCount:   3,
+		RunningCount:   1,
+		PendingCount:   2,
+		CircuitBreaker: "enabled/rollback",
+		CreatedAt:      "9:00:00",
+	}
+	var ecs EcsDeployments
+
+	r := NewRow(12)
+	err := ecs.Render(resp, "ecs:d", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"ecs-svc/123", "PRIMARY", "IN_PROGRESS", "web:42", "3", "1", "2", "0", "enabled/rollback", "9:00:00", "", ""}, r.Fields[0:])
+	assert.Equal(t, 8, ecs.Header().IndexOf("Circuit-Breaker", false))
+}
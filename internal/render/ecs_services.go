@@ -14,6 +14,8 @@ func (ecs EcsServices) Header() Header {
 	return Header{
 		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Desired", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Running", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
 		//HeaderColumn{Name: "Type", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "TaskDefinition", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "Arn", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
@@ -30,6 +32,8 @@ func (ecs EcsServices) Render(o interface{}, ns string, row *Row) error {
 	row.Fields = Fields{
 		ecsServiceResp.ServiceName,
 		ecsServiceResp.Status,
+		ecsServiceResp.DesiredCount,
+		ecsServiceResp.RunningCount,
 		//ecsServiceResp.,
 		ecsServiceResp.TaskDefinition,
 		ecsServiceResp.ServiceArn,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/derailed/tview"
 	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/config"
 )
 
 type EcsTasks struct {
@@ -21,6 +22,7 @@ func (ecs EcsTasks) Header() Header {
 		HeaderColumn{Name: "DesiredStatus", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "HealthStatus", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "StartedAt", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "StoppedReason", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "LaunchType", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "PlatformVersion", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
 		HeaderColumn{Name: "vCPU", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
@@ -36,11 +38,13 @@ func (ecs EcsTasks) Render(o interface{}, ns string, row *Row) error {
 		return fmt.Errorf("expected EcsServiceResp, but got %T", o)
 	}
 
-	var startedTime time.Time
+	var startedAt string
 	if task.StartedAt != nil {
-		startedTime = *task.StartedAt
+		localZone, _ := config.GetLocalTimeZone()
+		loc, _ := time.LoadLocation(localZone)
+		startedAt = task.StartedAt.In(loc).Format("Mon Jan _2 15:04:05 2006")
 	}
-	containerInstanceArn, taskDefinitionArn, lastStatus, desiredStatus, platformVersion, cpu, memory, group, startedBy := "", "", "", "", "", "", "", "", ""
+	containerInstanceArn, taskDefinitionArn, lastStatus, desiredStatus, platformVersion, cpu, memory, group, startedBy, stoppedReason := "", "", "", "", "", "", "", "", "", ""
 	if task.ContainerInstanceArn != nil {
 		containerInstanceArn = *task.ContainerInstanceArn
 	}
@@ -68,6 +72,9 @@ func (ecs EcsTasks) Render(o interface{}, ns string, row *Row) error {
 	if task.StartedBy != nil {
 		startedBy = *task.StartedBy
 	}
+	if task.StoppedReason != nil {
+		stoppedReason = *task.StoppedReason
+	}
 	row.ID = ns
 	row.Fields = Fields{
 		task.TaskId,
@@ -76,9 +83,10 @@ func (ecs EcsTasks) Render(o interface{}, ns string, row *Row) error {
 		containerInstanceArn,
 		lastStatus,
 		desiredStatus,
-		fmt.Sprintf("%v", task.HealthStatus),
-		startedTime.String(),
-		fmt.Sprintf("%v", task.LaunchType),
+		string(task.HealthStatus),
+		startedAt,
+		stoppedReason,
+		string(task.LaunchType),
 		platformVersion,
 		cpu,
 		memory,
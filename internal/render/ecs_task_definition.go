@@ -0,0 +1,58 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type EcsTaskDefinitionFamilies struct {
+}
+
+func (ecs EcsTaskDefinitionFamilies) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Family", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (ecs EcsTaskDefinitionFamilies) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.EcsTaskDefinitionFamilyResp)
+	if !ok {
+		return fmt.Errorf("expected EcsTaskDefinitionFamilyResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Family,
+	}
+	return nil
+}
+
+type EcsTaskDefinitions struct {
+}
+
+func (ecs EcsTaskDefinitions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Family", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Revision", SortIndicatorIdx: 0, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (ecs EcsTaskDefinitions) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.EcsTaskDefinitionResp)
+	if !ok {
+		return fmt.Errorf("expected EcsTaskDefinitionResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Family,
+		resp.Revision,
+		resp.Arn,
+	}
+	return nil
+}
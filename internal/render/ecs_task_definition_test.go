@@ -0,0 +1,20 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcsTaskDefinitionsRender(t *testing.T) {
+	resp := aws.EcsTaskDefinitionResp{Name: "web:42", Family: "web", Revision: "42", Arn: "arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"}
+	var ecs EcsTaskDefinitions
+
+	r := NewRow(4)
+	err := ecs.Render(resp, "ecs:tdr", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"web:42", "web", "42", "arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"}, r.Fields[0:])
+	assert.Equal(t, 2, ecs.Header().IndexOf("Revision", false))
+}
@@ -0,0 +1,36 @@
+package render
+
+import (
+	"testing"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcsTasksRender(t *testing.T) {
+	resp := aws.EcsTaskResp{
+		TaskId: "abc123",
+		Task: &types.Task{
+			TaskArn:       awsV2.String("arn:aws:ecs:us-east-1:123456789012:task/c/abc123"),
+			LastStatus:    awsV2.String("STOPPED"),
+			DesiredStatus: awsV2.String("STOPPED"),
+			HealthStatus:  types.HealthStatusUnhealthy,
+			LaunchType:    types.LaunchTypeFargate,
+			StoppedReason: awsV2.String("Essential container in task exited"),
+		},
+	}
+	var ecs EcsTasks
+
+	r := NewRow(15)
+	err := ecs.Render(resp, "ecs:t", &r)
+
+	assert.Nil(t, err)
+	h := ecs.Header()
+	assert.Equal(t, "STOPPED", r.Fields[h.IndexOf("LastStatus", false)])
+	assert.Equal(t, "UNHEALTHY", r.Fields[h.IndexOf("HealthStatus", false)])
+	assert.Equal(t, "", r.Fields[h.IndexOf("StartedAt", false)])
+	assert.Equal(t, "Essential container in task exited", r.Fields[h.IndexOf("StoppedReason", false)])
+	assert.Equal(t, "FARGATE", r.Fields[h.IndexOf("LaunchType", false)])
+}
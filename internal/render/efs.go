@@ -0,0 +1,110 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type FileSystems struct {
+}
+
+func (f FileSystems) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Size", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Throughput", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Performance", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Encrypted", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Lifecycle", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Mount-Targets", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (f FileSystems) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.FileSystemResp)
+	if !ok {
+		return fmt.Errorf("expected FileSystemResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Name,
+		resp.Size,
+		resp.Throughput,
+		resp.Performance,
+		resp.Encrypted,
+		resp.Lifecycle,
+		resp.MountTargets,
+		resp.State,
+	}
+	return nil
+}
+
+type MountTargets struct {
+}
+
+func (m MountTargets) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Mount-Target", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Subnet", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Zone", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "IP", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "VPC", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Security-Groups", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (m MountTargets) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.MountTargetResp)
+	if !ok {
+		return fmt.Errorf("expected MountTargetResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Subnet,
+		resp.Zone,
+		resp.IpAddress,
+		resp.State,
+		resp.VpcId,
+		resp.SecurityGroups,
+	}
+	return nil
+}
+
+type AccessPoints struct {
+}
+
+func (a AccessPoints) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Path", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Posix-User", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (a AccessPoints) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.AccessPointResp)
+	if !ok {
+		return fmt.Errorf("expected AccessPointResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Name,
+		resp.Path,
+		resp.PosixUser,
+		resp.State,
+	}
+	return nil
+}
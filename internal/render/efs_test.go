@@ -0,0 +1,41 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSystemsRender(t *testing.T) {
+	resp := aws.FileSystemResp{Id: "fs-0123456789abcdef0", Name: "shared", Size: "6.1 kB", Throughput: "elastic", Performance: "generalPurpose", Encrypted: "true", Lifecycle: "IA after 30 days", MountTargets: "2", State: "available"}
+	var f FileSystems
+
+	r := NewRow(9)
+	err := f.Render(resp, "efs", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"fs-0123456789abcdef0", "shared", "6.1 kB", "elastic", "generalPurpose", "true", "IA after 30 days", "2", "available"}, r.Fields[0:])
+}
+
+func TestMountTargetsRender(t *testing.T) {
+	resp := aws.MountTargetResp{Id: "fsmt-0123456789abcdef0", Subnet: "subnet-1", Zone: "us-east-1a", IpAddress: "10.0.1.25", State: "available", VpcId: "vpc-1", SecurityGroups: "sg-1,sg-2"}
+	var m MountTargets
+
+	r := NewRow(7)
+	err := m.Render(resp, "efs:m", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"fsmt-0123456789abcdef0", "subnet-1", "us-east-1a", "10.0.1.25", "available", "vpc-1", "sg-1,sg-2"}, r.Fields[0:])
+}
+
+func TestAccessPointsRender(t *testing.T) {
+	resp := aws.AccessPointResp{Id: "fsap-0123456789abcdef0", Name: "app", Path: "/app", PosixUser: "1000:1000", State: "available"}
+	var a AccessPoints
+
+	r := NewRow(5)
+	err := a.Render(resp, "efs:a", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"fsap-0123456789abcdef0", "app", "/app", "1000:1000", "available"}, r.Fields[0:])
+}
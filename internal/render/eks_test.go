@@ -0,0 +1,31 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEksClustersRender(t *testing.T) {
+	resp := aws.EksClusterResp{Name: "prod", Version: "1.27", Status: "ACTIVE", PlatformVersion: "eks.4", Endpoint: "https://prod.eks", Arn: "arn"}
+	var e EksClusters
+
+	r := NewRow(7)
+	err := e.Render(resp, "eks", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"prod", "1.27", "ACTIVE", "eks.4", "https://prod.eks", "", "arn"}, r.Fields[0:])
+}
+
+func TestEksNodeGroupsRender(t *testing.T) {
+	resp := aws.EksNodeGroupResp{Name: "workers", Type: aws.EksNodeGroupType, Status: "ACTIVE", Scaling: "2/1/3", InstanceTypes: "m5.large", AmiType: "AL2_x86_64", CapacityType: "ON_DEMAND", Version: "1.27"}
+	var e EksNodeGroups
+
+	r := NewRow(10)
+	err := e.Render(resp, "eks:ng", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"workers", "NodeGroup", "ACTIVE", "2/1/3", "m5.large", "AL2_x86_64", "ON_DEMAND", "1.27", "", ""}, r.Fields[0:])
+	assert.Equal(t, 3, e.Header().IndexOf("Desired/Min/Max", false))
+}
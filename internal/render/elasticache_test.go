@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachesRender(t *testing.T) {
+	resp := aws.CacheResp{Id: "sessions", Kind: "replication-group", Engine: "redis 7.0.7", NodeType: "cache.t3.micro", Nodes: "2", Shards: "1", Status: "available", Endpoint: "sessions.cache.amazonaws.com:6379"}
+	var c Caches
+
+	r := NewRow(8)
+	err := c.Render(resp, "cache", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"sessions", "replication-group", "redis 7.0.7", "cache.t3.micro", "2", "1", "available", "sessions.cache.amazonaws.com:6379"}, r.Fields[0:])
+}
+
+func TestCacheNodesRender(t *testing.T) {
+	resp := aws.CacheNodeResp{Id: "sessions-001", Role: "primary", Shard: "0001", Zone: "us-east-1a", Status: "available", Endpoint: "sessions-001.cache.amazonaws.com:6379", Created: "Mon Jan  2 15:04:05 2023"}
+	var c CacheNodes
+
+	r := NewRow(7)
+	err := c.Render(resp, "cache:n", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"sessions-001", "primary", "0001", "us-east-1a", "available", "sessions-001.cache.amazonaws.com:6379", "Mon Jan  2 15:04:05 2023"}, r.Fields[0:])
+}
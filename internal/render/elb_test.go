@@ -0,0 +1,42 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancersRender(t *testing.T) {
+	resp := aws.LoadBalancerResp{Name: "web", Type: "application", Scheme: "internet-facing", State: "active", DNSName: "web.elb.amazonaws.com", VpcId: "vpc-1", Arn: "arn"}
+	var l LoadBalancers
+
+	r := NewRow(8)
+	err := l.Render(resp, "elb", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"web", "application", "internet-facing", "active", "web.elb.amazonaws.com", "vpc-1", "", "arn"}, r.Fields[0:])
+}
+
+func TestElbRulesRender(t *testing.T) {
+	resp := aws.ElbRuleResp{Id: "HTTPS:443 #1", Listener: "HTTPS:443", Priority: "1", Conditions: "path-pattern=/api/*", Actions: "forward:api", TargetGroups: "api"}
+	var l ElbRules
+
+	r := NewRow(6)
+	err := l.Render(resp, "elb:l", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"HTTPS:443 #1", "HTTPS:443", "1", "path-pattern=/api/*", "forward:api", "api"}, r.Fields[0:])
+	assert.Equal(t, 5, l.Header().IndexOf("Target-Groups", false))
+}
+
+func TestElbTargetsRender(t *testing.T) {
+	resp := aws.ElbTargetHealthResp{Target: "i-1:80", TargetGroup: "api", State: "unhealthy", Reason: "Target.FailedHealthChecks", Description: "Health checks failed"}
+	var l ElbTargets
+
+	r := NewRow(5)
+	err := l.Render(resp, "elb:tg", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"i-1:80", "api", "unhealthy", "Target.FailedHealthChecks", "Health checks failed"}, r.Fields[0:])
+}
@@ -0,0 +1,52 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBusesRender(t *testing.T) {
+	resp := aws.EventBusResp{Name: "default", Rules: "3", Arn: "arn:aws:events:us-east-1:123:event-bus/default"}
+	var e EventBuses
+
+	r := NewRow(3)
+	err := e.Render(resp, "events", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"default", "3", "arn:aws:events:us-east-1:123:event-bus/default"}, r.Fields[0:])
+}
+
+func TestEventRulesRender(t *testing.T) {
+	resp := aws.EventRuleResp{Name: "nightly", State: "ENABLED", Trigger: "cron(0 2 * * ? *)", Targets: "report", ManagedBy: "", Description: "Nightly report"}
+	var e EventRules
+
+	r := NewRow(6)
+	err := e.Render(resp, "events:r", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"nightly", "ENABLED", "cron(0 2 * * ? *)", "report", "", "Nightly report"}, r.Fields[0:])
+}
+
+func TestEventTargetsRender(t *testing.T) {
+	resp := aws.EventTargetResp{Id: "report", Arn: "arn:aws:lambda:us-east-1:123:function:report", Input: "event", Role: "", DeadLetter: "report-dlq"}
+	var e EventTargets
+
+	r := NewRow(5)
+	err := e.Render(resp, "events:t", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"report", "arn:aws:lambda:us-east-1:123:function:report", "event", "", "report-dlq"}, r.Fields[0:])
+}
+
+func TestSchedulesRender(t *testing.T) {
+	resp := aws.ScheduleResp{Name: "cleanup", Group: "default", State: "ENABLED", Expression: "rate(1 hour)", Timezone: "UTC", Target: "cleanup", LastModified: "Mon Jan  2 15:04:05 2023"}
+	var s Schedules
+
+	r := NewRow(7)
+	err := s.Render(resp, "schedules", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"cleanup", "default", "ENABLED", "rate(1 hour)", "UTC", "cleanup", "Mon Jan  2 15:04:05 2023"}, r.Fields[0:])
+}
@@ -0,0 +1,108 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type KinesisStreams struct {
+}
+
+func (k KinesisStreams) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Mode", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Shards", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Retention", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Consumers", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Encryption", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: true},
+	}
+}
+
+func (k KinesisStreams) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.KinesisStreamResp)
+	if !ok {
+		return fmt.Errorf("expected KinesisStreamResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Status,
+		resp.Mode,
+		resp.Shards,
+		resp.Retention,
+		resp.Consumers,
+		resp.Encryption,
+		resp.Created,
+	}
+	return nil
+}
+
+type Shards struct {
+}
+
+func (s Shards) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Shard-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Parent", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Starting-Hash", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Ending-Hash", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Starting-Seq", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (s Shards) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ShardResp)
+	if !ok {
+		return fmt.Errorf("expected ShardResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.ShardId,
+		resp.State,
+		resp.Parent,
+		resp.StartingHash,
+		resp.EndingHash,
+		resp.StartingSeq,
+	}
+	return nil
+}
+
+type DeliveryStreams struct {
+}
+
+func (d DeliveryStreams) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Source", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Destination", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Buffering", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: true},
+	}
+}
+
+func (d DeliveryStreams) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.DeliveryStreamResp)
+	if !ok {
+		return fmt.Errorf("expected DeliveryStreamResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Status,
+		resp.Source,
+		resp.Destination,
+		resp.Buffering,
+		resp.Created,
+	}
+	return nil
+}
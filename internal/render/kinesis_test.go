@@ -0,0 +1,41 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKinesisStreamsRender(t *testing.T) {
+	resp := aws.KinesisStreamResp{Name: "clicks", Status: "ACTIVE", Mode: "PROVISIONED", Shards: "2", Retention: "24h", Consumers: "1", Encryption: "KMS", Created: "Mon Jan  2 15:04:05 2023"}
+	var k KinesisStreams
+
+	r := NewRow(8)
+	err := k.Render(resp, "kinesis", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"clicks", "ACTIVE", "PROVISIONED", "2", "24h", "1", "KMS", "Mon Jan  2 15:04:05 2023"}, r.Fields[0:])
+}
+
+func TestShardsRender(t *testing.T) {
+	resp := aws.ShardResp{ShardId: "shardId-000000000001", State: "OPEN", Parent: "shardId-000000000000", StartingHash: "0", EndingHash: "170141183460469231731687303715884105727", StartingSeq: "4959"}
+	var s Shards
+
+	r := NewRow(6)
+	err := s.Render(resp, "kinesis:s", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"shardId-000000000001", "OPEN", "shardId-000000000000", "0", "170141183460469231731687303715884105727", "4959"}, r.Fields[0:])
+}
+
+func TestDeliveryStreamsRender(t *testing.T) {
+	resp := aws.DeliveryStreamResp{Name: "clicks-to-s3", Status: "ACTIVE", Source: "kinesis clicks", Destination: "s3://logs/clicks/", Buffering: "5MB/300s", Created: "Mon Jan  2 15:04:05 2023"}
+	var d DeliveryStreams
+
+	r := NewRow(6)
+	err := d.Render(resp, "firehose", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"clicks-to-s3", "ACTIVE", "kinesis clicks", "s3://logs/clicks/", "5MB/300s", "Mon Jan  2 15:04:05 2023"}, r.Fields[0:])
+}
@@ -0,0 +1,42 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type KmsKeys struct {
+}
+
+func (k KmsKeys) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Key-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Alias", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Key-Spec", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Rotation", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Manager", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: true},
+	}
+}
+
+func (k KmsKeys) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.KmsKeyResp)
+	if !ok {
+		return fmt.Errorf("expected KmsKeyResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.KeyId,
+		resp.Alias,
+		resp.State,
+		resp.Spec,
+		resp.Rotation,
+		resp.Manager,
+		resp.CreatedAt,
+	}
+	return nil
+}
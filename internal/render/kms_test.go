@@ -0,0 +1,19 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKmsKeysRender(t *testing.T) {
+	resp := aws.KmsKeyResp{KeyId: "1234abcd", Alias: "alias/app", State: "Enabled", Spec: "SYMMETRIC_DEFAULT", Rotation: "enabled", Manager: "CUSTOMER", CreatedAt: "Mon Jan  2 10:00:00 2023"}
+	var k KmsKeys
+
+	r := NewRow(7)
+	err := k.Render(resp, "kms", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"1234abcd", "alias/app", "Enabled", "SYMMETRIC_DEFAULT", "enabled", "CUSTOMER", "Mon Jan  2 10:00:00 2023"}, r.Fields[0:])
+}
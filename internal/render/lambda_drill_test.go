@@ -0,0 +1,56 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLambdaVersionsRender(t *testing.T) {
+	resp := aws.LambdaVersionResp{Version: "2", Description: "d", Runtime: "go1.x", CodeSize: "10", CodeSha256: "sha", LastModified: "9:00:00"}
+	var l LambdaVersions
+
+	r := NewRow(6)
+	err := l.Render(resp, "lambda:v", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"2", "d", "go1.x", "10", "sha", "9:00:00"}, r.Fields[0:])
+	assert.Equal(t, 0, l.Header().IndexOf("Version", false))
+}
+
+func TestLambdaAliasesRender(t *testing.T) {
+	resp := aws.LambdaAliasResp{Name: "live", FunctionVersion: "2", Routing: "2=90%,3=10%", AliasArn: "arn"}
+	var l LambdaAliases
+
+	r := NewRow(5)
+	err := l.Render(resp, "lambda:a", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"live", "2", "2=90%,3=10%", "", "arn"}, r.Fields[0:])
+	assert.Equal(t, 2, l.Header().IndexOf("Routing", false))
+}
+
+func TestLambdaEventSourcesRender(t *testing.T) {
+	resp := aws.LambdaEventSourceResp{UUID: "u-1", Source: "sqs", EventSourceArn: "arn", State: "Enabled", BatchSize: "10", LastResult: "OK", LastModified: "9:00:00"}
+	var l LambdaEventSources
+
+	r := NewRow(7)
+	err := l.Render(resp, "lambda:esm", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"u-1", "sqs", "arn", "Enabled", "10", "OK", "9:00:00"}, r.Fields[0:])
+	assert.Equal(t, 3, l.Header().IndexOf("State", false))
+}
+
+func TestLambdaLayersRender(t *testing.T) {
+	resp := aws.LambdaLayerResp{Name: "deps", Version: "4", CodeSize: "100", Arn: "arn"}
+	var l LambdaLayers
+
+	r := NewRow(4)
+	err := l.Render(resp, "lambda:l", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"deps", "4", "100", "arn"}, r.Fields[0:])
+	assert.Equal(t, 3, l.Header().IndexOf("Arn", false))
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaEventSources struct {
+}
+
+// Header returns a header row.
+func (l LambdaEventSources) Header() Header {
+	return Header{
+		HeaderColumn{Name: "UUID", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Source", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Event-Source-Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Batch-Size", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Last-Result", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Last-Modified", SortIndicatorIdx: 5, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+	}
+}
+
+func (l LambdaEventSources) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.LambdaEventSourceResp)
+	if !ok {
+		return fmt.Errorf("Expected LambdaEventSourceResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.UUID,
+		resp.Source,
+		resp.EventSourceArn,
+		resp.State,
+		resp.BatchSize,
+		resp.LastResult,
+		resp.LastModified,
+	}
+
+	return nil
+}
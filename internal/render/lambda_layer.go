@@ -0,0 +1,38 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaLayers struct {
+}
+
+// Header returns a header row.
+func (l LambdaLayers) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Version", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Code-Size", SortIndicatorIdx: 5, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (l LambdaLayers) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.LambdaLayerResp)
+	if !ok {
+		return fmt.Errorf("Expected LambdaLayerResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Version,
+		resp.CodeSize,
+		resp.Arn,
+	}
+
+	return nil
+}
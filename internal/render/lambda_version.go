@@ -0,0 +1,42 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type LambdaVersions struct {
+}
+
+// Header returns a header row.
+func (l LambdaVersions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Version", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Description", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Runtime", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Code-Size", SortIndicatorIdx: 5, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Code-Sha256", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Last-Modified", SortIndicatorIdx: 5, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+	}
+}
+
+func (l LambdaVersions) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.LambdaVersionResp)
+	if !ok {
+		return fmt.Errorf("Expected LambdaVersionResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Version,
+		resp.Description,
+		resp.Runtime,
+		resp.CodeSize,
+		resp.CodeSha256,
+		resp.LastModified,
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostedZonesRender(t *testing.T) {
+	resp := aws.HostedZoneResp{Id: "Z123", Name: "example.com.", Type: "Private", Records: "4", Comment: "internal"}
+	var h HostedZones
+
+	r := NewRow(5)
+	err := h.Render(resp, "r53", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"Z123", "example.com.", "Private", "4", "internal"}, r.Fields[0:])
+}
+
+func TestRecordSetsRender(t *testing.T) {
+	resp := aws.RecordSetResp{Name: "www.example.com.", Type: "A", Alias: "web.elb.amazonaws.com.", Routing: "weighted:10", HealthCheckId: "hc-1"}
+	var rs RecordSets
+
+	r := NewRow(7)
+	err := rs.Render(resp, "r53:rr", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"www.example.com.", "A", "", "", "web.elb.amazonaws.com.", "weighted:10", "hc-1"}, r.Fields[0:])
+	assert.Equal(t, 4, rs.Header().IndexOf("Alias", false))
+}
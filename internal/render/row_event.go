@@ -1,6 +1,10 @@
 package render
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+)
 
 const (
 	// EventUnchanged notifies listener resource has not changed.
@@ -19,6 +23,10 @@ const (
 	EventClear
 )
 
+// ColorerFunc returns the text color of a row, tcell.ColorDefault keeping
+// the table color.
+type ColorerFunc func(h Header, re RowEvent) tcell.Color
+
 // ResEvent represents a resource event.
 type ResEvent int
 
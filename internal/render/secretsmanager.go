@@ -0,0 +1,66 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type Secrets struct {
+}
+
+func (s Secrets) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Rotation", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Last-Changed", SortIndicatorIdx: 5, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "KMS-Key", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Description", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+	}
+}
+
+func (s Secrets) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.SecretResp)
+	if !ok {
+		return fmt.Errorf("expected SecretResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Rotation,
+		resp.LastChanged,
+		resp.KmsKey,
+		resp.Description,
+	}
+	return nil
+}
+
+type SecretVersions struct {
+}
+
+func (s SecretVersions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Version-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Stages", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Last-Accessed", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+	}
+}
+
+func (s SecretVersions) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.SecretVersionResp)
+	if !ok {
+		return fmt.Errorf("expected SecretVersionResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.VersionId,
+		resp.Stages,
+		resp.CreatedAt,
+		resp.LastAccessed,
+	}
+	return nil
+}
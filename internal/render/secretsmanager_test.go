@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretsRender(t *testing.T) {
+	resp := aws.SecretResp{Name: "prod/db", Rotation: "every 30 days", LastChanged: "Mon Jan  2 10:00:00 2023", KmsKey: "aws/secretsmanager", Description: "db creds"}
+	var s Secrets
+
+	r := NewRow(5)
+	err := s.Render(resp, "secrets", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"prod/db", "every 30 days", "Mon Jan  2 10:00:00 2023", "aws/secretsmanager", "db creds"}, r.Fields[0:])
+}
+
+func TestSecretVersionsRender(t *testing.T) {
+	resp := aws.SecretVersionResp{VersionId: "v-1", Stages: "AWSCURRENT", CreatedAt: "Mon Jan  2 10:00:00 2023"}
+	var s SecretVersions
+
+	r := NewRow(4)
+	err := s.Render(resp, "secrets:v", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"v-1", "AWSCURRENT", "Mon Jan  2 10:00:00 2023", ""}, r.Fields[0:])
+}
@@ -0,0 +1,251 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+type StateMachines struct {
+}
+
+func (s StateMachines) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Type", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Created", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (s StateMachines) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.StateMachineResp)
+	if !ok {
+		return fmt.Errorf("expected StateMachineResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Type,
+		resp.Created,
+		resp.Arn,
+	}
+	return nil
+}
+
+type Executions struct {
+}
+
+func (e Executions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Name", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Status", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Started", SortIndicatorIdx: 1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Stopped", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Duration", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Arn", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (e Executions) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ExecutionResp)
+	if !ok {
+		return fmt.Errorf("expected ExecutionResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Name,
+		resp.Status,
+		resp.Started,
+		resp.Stopped,
+		resp.Duration,
+		resp.Arn,
+	}
+	return nil
+}
+
+// ColorerFunc colors executions by status.
+func (e Executions) ColorerFunc() ColorerFunc {
+	return func(h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("Status", false)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return tcell.ColorDefault
+		}
+		switch re.Row.Fields[idx] {
+		case "FAILED", "TIMED_OUT", "ABORTED":
+			return tcell.ColorRed
+		case "RUNNING":
+			return tcell.ColorYellow
+		case "SUCCEEDED":
+			return tcell.ColorGreen
+		default:
+			return tcell.ColorDefault
+		}
+	}
+}
+
+type ExecutionEvents struct {
+}
+
+func (e ExecutionEvents) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Id", SortIndicatorIdx: -1, Align: tview.AlignRight, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Timestamp", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Type", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "State", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Detail", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (e ExecutionEvents) Render(o interface{}, ns string, row *Row) error {
+	resp, ok := o.(aws.ExecutionEventResp)
+	if !ok {
+		return fmt.Errorf("expected ExecutionEventResp, but got %T", o)
+	}
+
+	row.ID = ns
+	row.Fields = Fields{
+		resp.Id,
+		resp.Timestamp,
+		resp.Type,
+		resp.State,
+		resp.Detail,
+	}
+	return nil
+}
+
+// ColorerFunc colors failed and succeeded history events.
+func (e ExecutionEvents) ColorerFunc() ColorerFunc {
+	return func(h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("Type", false)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return tcell.ColorDefault
+		}
+		t := re.Row.Fields[idx]
+		switch {
+		case strings.HasSuffix(t, "Failed"), strings.HasSuffix(t, "TimedOut"), strings.HasSuffix(t, "Aborted"):
+			return tcell.ColorRed
+		case t == "ExecutionSucceeded":
+			return tcell.ColorGreen
+		default:
+			return tcell.ColorDefault
+		}
+	}
+}
+
+type aslState struct {
+	Type    string
+	Next    string
+	End     bool
+	Default string
+	Choices []struct {
+		Next string
+	}
+	Catch []struct {
+		ErrorEquals []string
+		Next        string
+	}
+	Branches      []aslMachine
+	Iterator      *aslMachine
+	ItemProcessor *aslMachine
+}
+
+type aslMachine struct {
+	StartAt string
+	States  map[string]aslState
+}
+
+// StateGraph renders an ASL definition as an indented list of states and
+// their transitions, from StartAt onwards. States in visited are shown in
+// green and the failed state in red.
+func StateGraph(definition string, visited map[string]bool, failed string) (string, error) {
+	var m aslMachine
+	if err := json.Unmarshal([]byte(definition), &m); err != nil {
+		return "", fmt.Errorf("failed to parse state machine definition: %w", err)
+	}
+	var b strings.Builder
+	writeStateGraph(&b, m, visited, failed, "")
+	return b.String(), nil
+}
+
+func writeStateGraph(b *strings.Builder, m aslMachine, visited map[string]bool, failed, indent string) {
+	fmt.Fprintf(b, "%sStartAt %s\n", indent, tview.Escape(m.StartAt))
+	for _, name := range stateOrder(m) {
+		s := m.States[name]
+		marker, style := "  ", "-::-"
+		switch {
+		case name == failed:
+			marker, style = "✗ ", "red::b"
+		case visited[name]:
+			marker, style = "✓ ", "green::b"
+		}
+		fmt.Fprintf(b, "%s[%s]%s%s[-::-] (%s)", indent, style, marker, tview.Escape(name), s.Type)
+		switch {
+		case s.Next != "":
+			fmt.Fprintf(b, " -> %s", tview.Escape(s.Next))
+		case s.End, s.Type == "Succeed", s.Type == "Fail":
+			b.WriteString(" end")
+		}
+		b.WriteString("\n")
+		for i, c := range s.Choices {
+			fmt.Fprintf(b, "%s    choice %d -> %s\n", indent, i+1, tview.Escape(c.Next))
+		}
+		if s.Default != "" {
+			fmt.Fprintf(b, "%s    default -> %s\n", indent, tview.Escape(s.Default))
+		}
+		for _, c := range s.Catch {
+			fmt.Fprintf(b, "%s    catch %s -> %s\n", indent, tview.Escape(strings.Join(c.ErrorEquals, ",")), tview.Escape(c.Next))
+		}
+		for i, br := range s.Branches {
+			fmt.Fprintf(b, "%s    branch %d:\n", indent, i+1)
+			writeStateGraph(b, br, visited, failed, indent+"      ")
+		}
+		if it := s.ItemProcessor; it != nil || s.Iterator != nil {
+			if it == nil {
+				it = s.Iterator
+			}
+			fmt.Fprintf(b, "%s    iterator:\n", indent)
+			writeStateGraph(b, *it, visited, failed, indent+"      ")
+		}
+	}
+}
+
+// stateOrder returns the states reachable from StartAt in breadth first
+// order, followed by the unreachable ones sorted by name.
+func stateOrder(m aslMachine) []string {
+	seen := make(map[string]bool, len(m.States))
+	var order []string
+	queue := []string{m.StartAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		s, ok := m.States[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		order = append(order, name)
+		queue = append(queue, s.Next, s.Default)
+		for _, c := range s.Choices {
+			queue = append(queue, c.Next)
+		}
+		for _, c := range s.Catch {
+			queue = append(queue, c.Next)
+		}
+	}
+	var rest []string
+	for name := range m.States {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(order, rest...)
+}
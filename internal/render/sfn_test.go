@@ -0,0 +1,72 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateMachinesRender(t *testing.T) {
+	resp := aws.StateMachineResp{Name: "orders", Type: "STANDARD", Created: "Mon Jan  2 15:04:05 2023", Arn: "arn:aws:states:us-east-1:123:stateMachine:orders"}
+	var s StateMachines
+
+	r := NewRow(4)
+	err := s.Render(resp, "sfn", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"orders", "STANDARD", "Mon Jan  2 15:04:05 2023", "arn:aws:states:us-east-1:123:stateMachine:orders"}, r.Fields[0:])
+}
+
+func TestExecutionsRender(t *testing.T) {
+	resp := aws.ExecutionResp{Name: "run-1", Status: "FAILED", Started: "Mon Jan  2 15:04:05 2023", Stopped: "Mon Jan  2 15:05:35 2023", Duration: "1m30s", Arn: "arn:aws:states:us-east-1:123:execution:orders:run-1"}
+	var e Executions
+
+	r := NewRow(6)
+	err := e.Render(resp, "sfn:e", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"run-1", "FAILED", "Mon Jan  2 15:04:05 2023", "Mon Jan  2 15:05:35 2023", "1m30s", "arn:aws:states:us-east-1:123:execution:orders:run-1"}, r.Fields[0:])
+	assert.Equal(t, tcell.ColorRed, e.ColorerFunc()(e.Header(), RowEvent{Row: r}))
+}
+
+func TestExecutionEventsRender(t *testing.T) {
+	resp := aws.ExecutionEventResp{Id: "5", Timestamp: "Mon Jan  2 15:04:06 2023", Type: "TaskFailed", State: "Charge", Detail: "PaymentError"}
+	var e ExecutionEvents
+
+	r := NewRow(5)
+	err := e.Render(resp, "sfn:h", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"5", "Mon Jan  2 15:04:06 2023", "TaskFailed", "Charge", "PaymentError"}, r.Fields[0:])
+	assert.Equal(t, tcell.ColorRed, e.ColorerFunc()(e.Header(), RowEvent{Row: r}))
+}
+
+func TestStateGraph(t *testing.T) {
+	definition := `{
+		"StartAt": "Validate",
+		"States": {
+			"Notify": {"Type": "Task", "End": true},
+			"Charge": {"Type": "Task", "Next": "Done", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Notify"}]},
+			"Validate": {"Type": "Task", "Next": "Charge"},
+			"Done": {"Type": "Succeed"}
+		}
+	}`
+
+	graph, err := StateGraph(definition, map[string]bool{"Validate": true, "Charge": true}, "Charge")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"StartAt Validate",
+		"[green::b]✓ Validate[-::-] (Task) -> Charge",
+		"[red::b]✗ Charge[-::-] (Task) -> Done",
+		"    catch States.ALL -> Notify",
+		"[-::-]  Done[-::-] (Succeed) end",
+		"[-::-]  Notify[-::-] (Task) end",
+	}, strings.Split(strings.TrimSpace(graph), "\n"))
+
+	_, err = StateGraph("not json", nil, "")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicsRender(t *testing.T) {
+	resp := aws.TopicResp{Name: "orders", Arn: "arn:aws:sns:us-east-1:123:orders", Type: "Standard", DisplayName: "Orders", Subscriptions: "2", Pending: "1"}
+	var tp Topics
+
+	r := NewRow(6)
+	err := tp.Render(resp, "sns", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"orders", "Standard", "Orders", "2", "1", "arn:aws:sns:us-east-1:123:orders"}, r.Fields[0:])
+}
+
+func TestSubscriptionsRender(t *testing.T) {
+	resp := aws.SubscriptionResp{Id: "1234", Protocol: "sqs", Endpoint: "arn:aws:sqs:us-east-1:123:orders", Pending: "false", FilterPolicy: `{"event":["created"]}`}
+	var s Subscriptions
+
+	r := NewRow(5)
+	err := s.Render(resp, "sns:s", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"1234", "sqs", "arn:aws:sqs:us-east-1:123:orders", "false", `{"event":["created"]}`}, r.Fields[0:])
+}
@@ -0,0 +1,61 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+// maxBodyWidth is the max number of body characters rendered in the table.
+const maxBodyWidth = 80
+
+type SQSMessages struct {
+}
+
+// Header returns a header row.
+func (m SQSMessages) Header() Header {
+	return Header{
+		HeaderColumn{Name: "Message-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Sent", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: true},
+		HeaderColumn{Name: "Receive-Count", SortIndicatorIdx: 0, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Group-Id", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: true, MX: false, Time: false},
+		HeaderColumn{Name: "Attributes", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+		HeaderColumn{Name: "Body", SortIndicatorIdx: -1, Align: tview.AlignLeft, Hide: false, Wide: false, MX: false, Time: false},
+	}
+}
+
+func (m SQSMessages) Render(o interface{}, ns string, row *Row) error {
+	msg, ok := o.(aws.SQSMessageResp)
+	if !ok {
+		return fmt.Errorf("Expected SQSMessageResp, but got %T", o)
+	}
+
+	names := make([]string, 0, len(msg.MessageAttributes))
+	for k := range msg.MessageAttributes {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	row.ID = ns
+	row.Fields = Fields{
+		msg.MessageId,
+		msg.Sent,
+		msg.ReceiveCount,
+		msg.GroupId,
+		strings.Join(names, ","),
+		truncateBody(msg.Body),
+	}
+
+	return nil
+}
+
+func truncateBody(body string) string {
+	body = strings.Join(strings.Fields(body), " ")
+	if r := []rune(body); len(r) > maxBodyWidth {
+		return string(r[:maxBodyWidth-1]) + "…"
+	}
+	return body
+}
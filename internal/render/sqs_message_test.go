@@ -0,0 +1,48 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQSMessagesRender(t *testing.T) {
+	resp := aws.SQSMessageResp{
+		MessageId:    "m-1",
+		Sent:         "9:00:00",
+		ReceiveCount: "2",
+		GroupId:      "g-1",
+		Body:         "{\n  \"a\": 1\n}",
+		MessageAttributes: map[string]sqsTypes.MessageAttributeValue{
+			"trace": {}, "env": {},
+		},
+	}
+	var m SQSMessages
+
+	r := NewRow(6)
+	err := m.Render(resp, "sqs:m", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sqs:m", r.ID)
+
+	e := Fields{"m-1", "9:00:00", "2", "g-1", "env,trace", `{ "a": 1 }`}
+	assert.Equal(t, e, r.Fields[0:])
+
+	headers := m.Header()
+
+	assert.Equal(t, 0, headers.IndexOf("Message-Id", false))
+	assert.Equal(t, 2, headers.IndexOf("Receive-Count", false))
+	assert.Equal(t, 5, headers.IndexOf("Body", false))
+}
+
+func TestSQSMessagesRenderLongBody(t *testing.T) {
+	resp := aws.SQSMessageResp{MessageId: "m-1", Body: strings.Repeat("x", 200)}
+	var m SQSMessages
+
+	r := NewRow(6)
+	assert.Nil(t, m.Render(resp, "sqs:m", &r))
+	assert.Equal(t, maxBodyWidth, len([]rune(r.Fields[5])))
+}
@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParametersRender(t *testing.T) {
+	resp := aws.ParameterResp{Name: "password", Path: "/app/db/password", Type: "SecureString", Version: "3", Tier: "Standard", LastModified: "Mon Jan  2 10:00:00 2023"}
+	var p Parameters
+
+	r := NewRow(6)
+	err := p.Render(resp, "ssm:p", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"password", "/app/db/password", "SecureString", "3", "Standard", "Mon Jan  2 10:00:00 2023"}, r.Fields[0:])
+}
+
+func TestParameterVersionsRender(t *testing.T) {
+	resp := aws.ParameterVersionResp{Version: "2", Type: "String", Tier: "Standard", Labels: "prod", ModifiedBy: "arn:aws:iam::123:user/ops", LastModified: "Mon Jan  2 10:00:00 2023"}
+	var p ParameterVersions
+
+	r := NewRow(6)
+	err := p.Render(resp, "ssm:p:v", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Fields{"2", "String", "Standard", "prod", "arn:aws:iam::123:user/ops", "Mon Jan  2 10:00:00 2023"}, r.Fields[0:])
+}
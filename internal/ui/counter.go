@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Counter shows a labeled count in the header, highlighted when not zero.
+type Counter struct {
+	*tview.TextView
+	label, unit string
+	count       int
+}
+
+// NewCounter returns a new counter, unknown until set.
+func NewCounter(label, unit string) *Counter {
+	c := Counter{
+		TextView: tview.NewTextView(),
+		label:    label,
+		unit:     unit,
+		count:    -1,
+	}
+	c.SetDynamicColors(true)
+	c.SetBackgroundColor(tcell.ColorBlack.TrueColor())
+	c.build()
+	return &c
+}
+
+// GetLabel returns the counter label, without color tags so it can be
+// measured and padded like drop down labels.
+func (c *Counter) GetLabel() string {
+	return c.label
+}
+
+// SetLabel sets the counter label.
+func (c *Counter) SetLabel(label string) {
+	c.label = label
+	c.build()
+}
+
+// SetCount sets the count, a negative count meaning unknown.
+func (c *Counter) SetCount(n int) {
+	c.count = n
+	c.build()
+}
+
+// GetCount returns the count.
+func (c *Counter) GetCount() int {
+	return c.count
+}
+
+func (c *Counter) build() {
+	label := fmt.Sprintf("[%s::b]%s", "orange", c.label)
+	switch {
+	case c.count < 0:
+		c.SetText(fmt.Sprintf("%s  [antiquewhite::]-", label))
+	case c.count == 0:
+		c.SetText(fmt.Sprintf("%s  [antiquewhite::]0 %s", label, c.unit))
+	default:
+		c.SetText(fmt.Sprintf("%s  [orangered::b]%d %s", label, c.count, c.unit))
+	}
+}
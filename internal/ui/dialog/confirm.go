@@ -1,3 +1,39 @@
 package dialog
 
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
 const confirmKey = "confirm"
+
+// ShowConfirm pops a confirmation dialog.
+func ShowConfirm(pages *ui.Pages, title, msg string, ack func(), cancel func()) {
+	f := newForm(tcell.ColorAqua)
+	f.AddButton("Cancel", func() {
+		dismissConfirm(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissConfirm(pages)
+		ack()
+	})
+	styleButtons(f)
+	f.SetFocus(0)
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(tcell.ColorFuchsia)
+	modal.SetBackgroundColor(tcell.ColorBlack.TrueColor())
+	modal.SetBorderColor(tcell.ColorBlue)
+	modal.SetDoneFunc(func(int, string) {
+		dismissConfirm(pages)
+		cancel()
+	})
+	pages.AddPage(confirmKey, modal, false, false)
+	pages.ShowPage(confirmKey)
+}
+
+func dismissConfirm(pages *ui.Pages) {
+	pages.RemovePage(confirmKey)
+}
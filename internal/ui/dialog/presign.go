@@ -0,0 +1,125 @@
+package dialog
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+const presignKey = "presign"
+
+// PresignFunc signs a request for the given method, valid for expiry.
+type PresignFunc func(method string, expiry time.Duration) (string, error)
+
+// PresignOpts represents presign dialog options.
+type PresignOpts struct {
+	Object        string
+	DefaultExpiry time.Duration
+	MaxExpiry     time.Duration
+	Sign          PresignFunc
+	// Done is called with the signed url once signing succeeds.
+	Done func(url string)
+}
+
+// ShowPresign pops a dialog asking for a presigned url expiry and method.
+func ShowPresign(pages *ui.Pages, opts PresignOpts) {
+	expiry, method := formatExpiry(opts.DefaultExpiry), http.MethodGet
+	f := newForm(tcell.ColorAqua)
+	f.AddInputField("Expiry:", expiry, 0, nil, func(changed string) {
+		expiry = changed
+	})
+	methods := []string{http.MethodGet, http.MethodPut}
+	f.AddDropDown("Method:", methods, 0, func(option string, _ int) {
+		method = option
+	})
+	f.AddButton("Sign", func() {
+		d, err := parseExpiry(expiry)
+		if err == nil && (d <= 0 || d > opts.MaxExpiry) {
+			err = fmt.Errorf("expiry must be between 1s and %s", formatExpiry(opts.MaxExpiry))
+		}
+		if err != nil {
+			ShowError(pages, err.Error())
+			return
+		}
+		url, err := opts.Sign(method, d)
+		if err != nil {
+			ShowError(pages, fmt.Sprintf("Signing failed: %v", err))
+			return
+		}
+		dismissPresign(pages)
+		if opts.Done != nil {
+			opts.Done(url)
+		}
+		showURL(pages, method, d, url)
+	})
+	f.AddButton("Cancel", func() {
+		dismissPresign(pages)
+	})
+	styleButtons(f)
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<presign>", f)
+	modal.SetText(fmt.Sprintf("Presign %s (max %s)", opts.Object, formatExpiry(opts.MaxExpiry)))
+	modal.SetTextColor(tcell.ColorPapayaWhip)
+	modal.SetBackgroundColor(tcell.ColorBlack.TrueColor())
+	modal.SetBorderColor(tcell.ColorBlue)
+	modal.SetDoneFunc(func(int, string) {
+		dismissPresign(pages)
+	})
+	pages.AddPage(presignKey, modal, false, false)
+	pages.ShowPage(presignKey)
+}
+
+func showURL(pages *ui.Pages, method string, expiry time.Duration, url string) {
+	f := newForm(tcell.ColorAqua)
+	f.AddButton("Dismiss", func() {
+		dismissPresign(pages)
+	})
+	styleButtons(f)
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<presigned url>", f)
+	modal.SetText(fmt.Sprintf("%s url valid for %s\n\n%s", method, formatExpiry(expiry), tview.Escape(url)))
+	modal.SetTextColor(tcell.ColorPapayaWhip)
+	modal.SetBackgroundColor(tcell.ColorBlack.TrueColor())
+	modal.SetBorderColor(tcell.ColorBlue)
+	modal.SetDoneFunc(func(int, string) {
+		dismissPresign(pages)
+	})
+	pages.AddPage(presignKey, modal, false, false)
+	pages.ShowPage(presignKey)
+}
+
+func dismissPresign(pages *ui.Pages) {
+	pages.RemovePage(presignKey)
+}
+
+// parseExpiry parses a go duration, also accepting a trailing day unit i.e 7d.
+func parseExpiry(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid expiry %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiry %q", s)
+	}
+	return d, nil
+}
+
+func formatExpiry(d time.Duration) string {
+	if d >= 24*time.Hour && d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return d.String()
+}
@@ -0,0 +1,53 @@
+package dialog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpiry(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		d   time.Duration
+		err bool
+	}{
+		"minutes":  {s: "15m", d: 15 * time.Minute},
+		"compound": {s: "1h30m", d: 90 * time.Minute},
+		"days":     {s: "7d", d: 7 * 24 * time.Hour},
+		"spaces":   {s: " 2d ", d: 48 * time.Hour},
+		"seconds":  {s: "90s", d: 90 * time.Second},
+		"empty":    {s: "", err: true},
+		"no-unit":  {s: "15", err: true},
+		"frac-day": {s: "1.5d", err: true},
+		"garbage":  {s: "soon", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := parseExpiry(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.d, d)
+		})
+	}
+}
+
+func TestFormatExpiry(t *testing.T) {
+	uu := map[string]struct {
+		d time.Duration
+		s string
+	}{
+		"days":    {d: 7 * 24 * time.Hour, s: "7d"},
+		"hours":   {d: 36 * time.Hour, s: "36h0m0s"},
+		"minutes": {d: 15 * time.Minute, s: "15m0s"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.s, formatExpiry(u.d))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package dialog
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+const promptKey = "prompt"
+
+type (
+	okFunc     func(string)
+	cancelFunc func()
+
+	// FormOkFunc receives the form values in field order once the form is
+	// dismissed. Returning an error reports it and shows the form again.
+	FormOkFunc func(values []string) error
+)
+
+// FormField represents an input of a form dialog. Fields with options
+// render as drop downs.
+type FormField struct {
+	Label   string
+	Value   string
+	Options []string
+}
+
+// ShowPrompt pops a dialog asking for a single value.
+func ShowPrompt(pages *ui.Pages, title, label, value string, ok okFunc, cancel cancelFunc) {
+	ShowForm(pages, title, "", []FormField{{Label: label, Value: value}}, func(values []string) error {
+		ok(values[0])
+		return nil
+	}, cancel)
+}
+
+// ShowForm pops a dialog asking for several values.
+func ShowForm(pages *ui.Pages, title, msg string, fields []FormField, ok FormOkFunc, cancel cancelFunc) {
+	var modal *tview.ModalForm
+	show := func() {
+		pages.AddPage(promptKey, modal, false, false)
+		pages.ShowPage(promptKey)
+	}
+	values := make([]string, len(fields))
+	f := newForm(tcell.ColorAqua)
+	for i, field := range fields {
+		i := i
+		values[i] = field.Value
+		if len(field.Options) > 0 {
+			initial := 0
+			for j, o := range field.Options {
+				if o == field.Value {
+					initial = j
+				}
+			}
+			values[i] = field.Options[initial]
+			f.AddDropDown(field.Label, field.Options, initial, func(option string, _ int) {
+				values[i] = option
+			})
+			continue
+		}
+		f.AddInputField(field.Label, field.Value, 0, nil, func(changed string) {
+			values[i] = changed
+		})
+	}
+	f.AddButton("OK", func() {
+		// Dismiss first so callbacks injecting views or suspending the app
+		// do not run under the modal.
+		dismissPrompt(pages)
+		if err := ok(values); err != nil {
+			show()
+			ShowError(pages, err.Error())
+		}
+	})
+	f.AddButton("Cancel", func() {
+		dismissPrompt(pages)
+		cancel()
+	})
+	styleButtons(f)
+	f.SetFocus(0)
+	modal = tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(tcell.ColorPapayaWhip)
+	modal.SetBackgroundColor(tcell.ColorBlack.TrueColor())
+	modal.SetBorderColor(tcell.ColorBlue)
+	modal.SetDoneFunc(func(int, string) {
+		dismissPrompt(pages)
+		cancel()
+	})
+	show()
+}
+
+func dismissPrompt(pages *ui.Pages) {
+	pages.RemovePage(promptKey)
+}
+
+func newForm(fieldColor tcell.Color) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tcell.ColorDarkSlateBlue).
+		SetButtonTextColor(tcell.ColorBlack.TrueColor()).
+		SetLabelColor(tcell.ColorWhite.TrueColor()).
+		SetFieldTextColor(fieldColor)
+	return f
+}
+
+func styleButtons(f *tview.Form) {
+	for i := 0; i < f.GetButtonCount(); i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(tcell.ColorDodgerBlue)
+			b.SetLabelColorActivated(tcell.ColorBlack.TrueColor())
+		}
+	}
+}
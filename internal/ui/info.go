@@ -40,10 +40,18 @@ func (i *Info) padDropDownLabels() {
 	}
 
 	for _, p := range i.items {
-		d, ok := p.(*DropDown)
-		if ok {
+		if c, ok := p.(*Counter); ok && len(c.GetLabel()) > maxLabelLen {
+			maxLabelLen = len(c.GetLabel())
+		}
+	}
+
+	for _, p := range i.items {
+		switch d := p.(type) {
+		case *DropDown:
 			d.SetFieldWidth(maxOptionLen + DropdownPadSpaces)
 			d.SetLabel(d.GetLabel() + strings.Repeat(" ", (maxLabelLen-len(d.GetLabel()))+1))
+		case *Counter:
+			d.SetLabel(d.GetLabel() + strings.Repeat(" ", (maxLabelLen-len(d.GetLabel()))+1))
 		}
 	}
 }
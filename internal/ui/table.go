@@ -21,6 +21,8 @@ type Table struct {
 	actions KeyActions
 	wide    bool
 	toast   bool
+	filter  string
+	colorer render.ColorerFunc
 }
 
 // NewTable returns a new table view.
@@ -36,6 +38,17 @@ func NewTable(res string) *Table {
 	}
 }
 
+// GetSelectedColumn returns the content of the named column for the currently
+// selected row, wide columns being only available in wide mode.
+func (t *Table) GetSelectedColumn(name string) string {
+	for i, c := range t.header.Columns(t.wide) {
+		if c == name {
+			return t.GetSelectedCell(i)
+		}
+	}
+	return ""
+}
+
 // Init initializes the component.
 func (t *Table) Init(ctx context.Context) {
 	t.SetFixed(1, 0)
@@ -69,6 +82,22 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// SetFilter filters the table rows with a fuzzy or regex query.
+func (t *Table) SetFilter(q string) {
+	t.filter = q
+	t.Refresh()
+}
+
+// GetFilter returns the active row filter.
+func (t *Table) GetFilter() string {
+	return t.filter
+}
+
+// SetColorerFn sets the row colorer.
+func (t *Table) SetColorerFn(f render.ColorerFunc) {
+	t.colorer = f
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() KeyActions {
 	return t.actions
@@ -90,6 +119,7 @@ func (t *Table) doUpdate(data *render.TableData) {
 	cols := t.header.Columns(t.wide)
 
 	custData := data.Customize(cols, t.wide)
+	custData.RowEvents = filterRows(t.filter, custData.RowEvents)
 	t.Clear()
 	var col int
 	for _, h := range custData.Header {
@@ -114,7 +144,16 @@ func (t *Table) doUpdate(data *render.TableData) {
 
 func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header) {
 
-	marked := t.IsMarked(re.Row.ID)
+	var marked bool
+	if len(re.Row.Fields) > 0 {
+		marked = t.IsMarked(re.Row.Fields[0])
+	}
+	color := tcell.ColorSkyblue
+	if t.colorer != nil {
+		if c := t.colorer(h, re); c != tcell.ColorDefault {
+			color = c
+		}
+	}
 	var col int
 	for c, field := range re.Row.Fields {
 		if c >= len(h) {
@@ -129,7 +168,7 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header) {
 
 		cell := tview.NewTableCell(field)
 		cell.SetAttributes(tcell.AttrNone)
-		cell.SetTextColor(tcell.ColorSkyblue)
+		cell.SetTextColor(color)
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
 		if marked {
@@ -2,11 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/one2nc/cloudlens/internal/color"
+	"github.com/one2nc/cloudlens/internal/dao"
 	"github.com/one2nc/cloudlens/internal/render"
 	"github.com/rs/zerolog/log"
+	"github.com/sahilm/fuzzy"
 )
 
 const (
@@ -35,3 +38,33 @@ func sortIndicator(sort, asc bool, hc render.HeaderColumn) string {
 	}
 	return fmt.Sprintf("%s%s", color.ColorizeAt(hc.Name, hc.SortIndicatorIdx, "red", true), color.ColorizeAt(order, 0, "green", false))
 }
+
+// filterRows keeps the rows matching a fuzzy (-f) or regex query. Queries
+// that are not valid regexes are matched literally.
+func filterRows(q string, rows render.RowEvents) render.RowEvents {
+	if q == "" {
+		return rows
+	}
+	lines := make([]string, len(rows))
+	for i, re := range rows {
+		lines[i] = strings.Join(re.Row.Fields, " ")
+	}
+	filtered := make(render.RowEvents, 0, len(rows))
+	if dao.IsFuzzySelector(q) {
+		matches := fuzzy.Find(strings.TrimSpace(q[2:]), lines)
+		for _, m := range matches {
+			filtered = append(filtered, rows[m.Index])
+		}
+		return filtered
+	}
+	rx, err := regexp.Compile(`(?i)` + q)
+	if err != nil {
+		rx = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(q))
+	}
+	for i, l := range lines {
+		if rx.MatchString(l) {
+			filtered = append(filtered, rows[i])
+		}
+	}
+	return filtered
+}
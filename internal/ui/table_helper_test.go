@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterRows(t *testing.T) {
+	rows := render.RowEvents{
+		{Row: render.Row{ID: "1", Fields: render.Fields{"www.example.com.", "A", "203.0.113.10"}}},
+		{Row: render.Row{ID: "2", Fields: render.Fields{"api.example.com.", "CNAME", "lb.example.com."}}},
+		{Row: render.Row{ID: "3", Fields: render.Fields{"(legacy).example.com.", "TXT", "v=spf1 -all"}}},
+	}
+	uu := map[string]struct {
+		q   string
+		ids []string
+	}{
+		"none":    {q: "", ids: []string{"1", "2", "3"}},
+		"regex":   {q: "^(www|api)", ids: []string{"1", "2"}},
+		"case":    {q: "cname", ids: []string{"2"}},
+		"fuzzy":   {q: "-f wwexm", ids: []string{"1"}},
+		"literal": {q: "(legacy", ids: []string{"3"}},
+		"nomatch": {q: "mx", ids: []string{}},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ids := []string{}
+			for _, re := range filterRows(u.q, rows) {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
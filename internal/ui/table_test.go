@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"context"
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableGetSelectedColumn(t *testing.T) {
+	data := render.NewTableData()
+	data.Header = render.Header{
+		render.HeaderColumn{Name: "Stage"},
+		render.HeaderColumn{Name: "Access-Log", Wide: true},
+		render.HeaderColumn{Name: "Invoke-URL"},
+	}
+	data.RowEvents = render.RowEvents{
+		{Row: render.Row{ID: "prod", Fields: render.Fields{"prod", "/aws/apigw/prod", "https://a1b2c3.execute-api.us-east-1.amazonaws.com/prod"}}},
+	}
+
+	for _, wide := range []bool{false, true} {
+		tv := NewTable("apigw:s")
+		tv.Init(context.Background())
+		tv.wide = wide
+		tv.Update(data)
+		tv.Select(1, 0)
+
+		assert.Equal(t, "https://a1b2c3.execute-api.us-east-1.amazonaws.com/prod", tv.GetSelectedColumn("Invoke-URL"), "wide %t", wide)
+		assert.Equal(t, "", tv.GetSelectedColumn("Deployment"), "wide %t", wide)
+	}
+}
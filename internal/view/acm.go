@@ -0,0 +1,55 @@
+package view
+
+import (
+	"context"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/render"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+// Acm lists certificates, highlighting the ones expiring within the
+// configured window and counting them in the header.
+type Acm struct {
+	ResourceViewer
+}
+
+func NewAcm(resource string) ResourceViewer {
+	var a Acm
+	a.ResourceViewer = NewBrowser(resource)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+// Init registers the view as a listener of the certificates.
+func (a *Acm) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().SetColorerFn(render.Certificates{}.ColorerFunc(a.expiryDays()))
+	a.GetTable().GetModel().AddListener(a)
+	return nil
+}
+
+// TableDataChanged updates the header count of expiring certificates.
+func (a *Acm) TableDataChanged(data *render.TableData) {
+	n := render.ExpiringCertificates(data, a.expiryDays())
+	a.App().QueueUpdateDraw(func() {
+		a.App().certs().SetCount(n)
+	})
+}
+
+func (a *Acm) expiryDays() int {
+	return a.App().Config().Cloudlens.GetCertExpiryDays()
+}
+
+func (a *Acm) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD:    ui.NewKeyAction("Sort Domain", a.GetTable().SortColCmd("Domain", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", a.GetTable().SortColCmd("Status", true), true),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Not-After", a.GetTable().SortColCmd("Not-After", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Certificate"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(a, "Certificate"), false),
+	})
+}
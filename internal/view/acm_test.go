@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAcm(t *testing.T) {
+	acm := NewAcm("acm")
+	assert.Nil(t, acm.Init(makeCtx()))
+	assert.Equal(t, "acm", acm.Name())
+	assert.Equal(t, 9, len(acm.Hints()))
+}
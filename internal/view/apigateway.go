@@ -0,0 +1,143 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Apigw struct {
+	ResourceViewer
+}
+
+func NewApigw(resource string) ResourceViewer {
+	var a Apigw
+	a.ResourceViewer = NewBrowser(resource)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *Apigw) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", a.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftP:    ui.NewKeyAction("Sort Protocol", a.GetTable().SortColCmd("Protocol", true), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", a.GetTable().SortColCmd("Created", true), true),
+		ui.KeyO:         ui.NewKeyAction("Routes", a.drillCmd("routes", NewApiRoutes), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "API"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Stages", a.drillCmd("stages", NewApiStages), false),
+	})
+}
+
+func (a *Apigw) drillCmd(what string, viewerFn func(string) ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		id := a.GetTable().GetSelectedItem()
+		if id == "" {
+			return nil
+		}
+		v := viewerFn(id)
+		ctx := context.WithValue(a.App().GetContext(), internal.ApiProtocol, a.GetTable().GetSelectedCell(2))
+		a.App().SetContext(context.WithValue(ctx, internal.ApiId, id))
+		a.App().inject(v)
+		v.GetTable().SetTitle(fmt.Sprintf(" apigw://%s/%s ", id, what))
+		a.App().Flash().Infof("Viewing %s %s...", id, what)
+		return nil
+	}
+}
+
+type ApiStages struct {
+	id string
+	ResourceViewer
+}
+
+func NewApiStages(id string) ResourceViewer {
+	var a ApiStages
+	a.id = id
+	a.ResourceViewer = NewBrowser(internal.LowercaseApiStages)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *ApiStages) Name() string {
+	return a.id
+}
+
+func (a *ApiStages) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Stage", a.GetTable().SortColCmd("Stage", true), true),
+		ui.KeyShiftU:    ui.NewKeyAction("Sort Updated", a.GetTable().SortColCmd("Updated", true), true),
+		ui.KeyC:         ui.NewKeyAction("Copy Invoke URL", a.copyUrlCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Stage"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(a, "Stage"), false),
+	})
+}
+
+func (a *ApiStages) copyUrlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.GetTable().GetSelectedItem() == "" {
+		return nil
+	}
+	url := a.GetTable().GetSelectedColumn("Invoke-URL")
+	if err := clipboard.WriteAll(url); err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	a.App().Flash().Infof("%s copied to the clipboard", url)
+	return nil
+}
+
+type ApiRoutes struct {
+	id string
+	ResourceViewer
+}
+
+func NewApiRoutes(id string) ResourceViewer {
+	var a ApiRoutes
+	a.id = id
+	a.ResourceViewer = NewBrowser(internal.LowercaseApiRoutes)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *ApiRoutes) Name() string {
+	return a.id
+}
+
+func (a *ApiRoutes) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR:    ui.NewKeyAction("Sort Route", a.GetTable().SortColCmd("Route", true), true),
+		ui.KeyShiftI:    ui.NewKeyAction("Sort Integration", a.GetTable().SortColCmd("Integration", true), true),
+		ui.KeyShiftA:    ui.NewKeyAction("Sort Authorization", a.GetTable().SortColCmd("Authorization", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Route"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Lambda", a.enterCmd, false),
+	})
+}
+
+// enterCmd opens the Lambda view on the function invoked by the selected
+// route, describing the route when it targets anything else.
+func (a *ApiRoutes) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	route := a.GetTable().GetSelectedItem()
+	if route == "" {
+		return nil
+	}
+	target := a.GetTable().GetSelectedCell(2)
+	if !strings.HasPrefix(target, "lambda:") {
+		return describeSelected(a, "Route")(evt)
+	}
+	name := strings.TrimPrefix(target, "lambda:")
+	v := NewLambda(internal.LowercaseLamda)
+	if err := a.App().inject(v); err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	v.GetTable().Filter(regexp.QuoteMeta(name))
+	a.App().Flash().Infof("Viewing function %s of %s...", name, route)
+	return nil
+}
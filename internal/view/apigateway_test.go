@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApigw(t *testing.T) {
+	apigw := NewApigw("apigw")
+	assert.Nil(t, apigw.Init(makeCtx()))
+	assert.Equal(t, "apigw", apigw.Name())
+	assert.Equal(t, 10, len(apigw.Hints()))
+}
+
+func TestNewApiStages(t *testing.T) {
+	stages := NewApiStages("a1b2c3")
+	assert.Nil(t, stages.Init(makeCtx()))
+	assert.Equal(t, "a1b2c3", stages.Name())
+	assert.Equal(t, 9, len(stages.Hints()))
+}
+
+func TestNewApiRoutes(t *testing.T) {
+	routes := NewApiRoutes("a1b2c3")
+	assert.Nil(t, routes.Init(makeCtx()))
+	assert.Equal(t, "a1b2c3", routes.Name())
+	assert.Equal(t, 9, len(routes.Hints()))
+}
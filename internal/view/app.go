@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	cfg "github.com/aws/aws-sdk-go-v2/config"
 	awsS "github.com/aws/aws-sdk-go/aws"
 	"github.com/derailed/tview"
@@ -41,6 +42,10 @@ type App struct {
 	IsPageContentSorted bool
 	version             string
 	cloudConfig         config.CloudConfig
+	config              *config.Config
+	// sessionGen is bumped on each session switch, so background results of
+	// a previous session are dropped.
+	sessionGen int
 }
 
 func NewApp() *App {
@@ -48,6 +53,7 @@ func NewApp() *App {
 		App:                 ui.NewApp(),
 		Content:             NewPageStack(),
 		IsPageContentSorted: false,
+		config:              config.NewConfig(),
 	}
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App)
 	return &a
@@ -60,6 +66,9 @@ func (a *App) Init(version string, cloudConfig config.CloudConfig) error {
 	a.SetContext(ctx)
 
 	a.version = model.NormalizeVersion(version)
+	if err := a.config.Load(config.CloudlensConfigFile); err != nil && !os.IsNotExist(err) {
+		log.Warn().Msgf("Unable to load cloudlens config %q: %v", config.CloudlensConfigFile, err)
+	}
 	if err := a.Content.Init(ctx); err != nil {
 		return err
 	}
@@ -145,13 +154,16 @@ func (a *App) handleAWS() {
 	r.SetSelectedFunc(a.regionChanged)
 	a.Views()["region"] = r
 
+	a.Views()["certs"] = ui.NewCounter("Certs:", "expiring")
+
 	infoData := map[string]tview.Primitive{
-		"profile": a.profile(),
-		"region":  a.region(),
+		"profile":  a.profile(),
+		"region":   a.region(),
+		"tlsCerts": a.certs(),
 	}
 	a.Views()["info"] = ui.NewInfo(infoData)
 	a.toggleHeader(true)
-
+	a.countExpiringCerts(cfg)
 }
 
 func (a *App) handleGCP() error {
@@ -300,6 +312,11 @@ func (a *App) Run() error {
 	return nil
 }
 
+// Config returns the cloudlens configuration.
+func (a *App) Config() *config.Config {
+	return a.config
+}
+
 func (a *App) GetContext() context.Context {
 	return a.context
 }
@@ -447,6 +464,9 @@ func (a *App) refreshSession(profile string, region string) {
 	}
 	ctx := context.WithValue(a.GetContext(), internal.KeySession, cfg)
 	a.SetContext(ctx)
+	a.sessionGen++
+	a.certs().SetCount(-1)
+	a.countExpiringCerts(cfg)
 	stackedViews := a.Content.Pages.Stack.Flatten()
 	a.gotoResource(stackedViews[0], "", true)
 	a.App.Flash().Infof("Refreshing %v...", stackedViews[0])
@@ -532,6 +552,31 @@ func (a *App) region() *ui.DropDown {
 	return a.Views()["region"].(*ui.DropDown)
 }
 
+func (a *App) certs() *ui.Counter {
+	return a.Views()["certs"].(*ui.Counter)
+}
+
+// countExpiringCerts counts the expiring certificates of a session in the
+// background, so the header shows them without visiting the acm view.
+func (a *App) countExpiringCerts(session awsV2.Config) {
+	gen := a.sessionGen
+	go func() {
+		certs, err := aws.ListCertificates(session)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to count expiring certificates")
+			return
+		}
+		n := aws.ExpiringCertificates(certs, a.Config().Cloudlens.GetCertExpiryDays())
+		a.QueueUpdateDraw(func() {
+			// Skip counts of a session switched away from meanwhile.
+			if gen != a.sessionGen {
+				return
+			}
+			a.certs().SetCount(n)
+		})
+	}()
+}
+
 func readAndValidateProfile() ([]string, error) {
 	profiles, err := aws.GetProfiles()
 	if err != nil {
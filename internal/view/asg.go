@@ -0,0 +1,272 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+const (
+	refreshStart   = "start"
+	refreshCancel  = "cancel"
+	processSuspend = "suspend"
+	processResume  = "resume"
+	processAll     = "all"
+)
+
+type Asg struct {
+	ResourceViewer
+}
+
+func NewAsg(resource string) ResourceViewer {
+	var a Asg
+	a.ResourceViewer = NewBrowser(resource)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *Asg) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD:    ui.NewKeyAction("Sort Desired", a.GetTable().SortColCmd("Desired", false), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", a.GetTable().SortColCmd("Created", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Auto Scaling Group"), true),
+		ui.KeyS:         ui.NewKeyAction("Set Desired", a.desiredCmd, true),
+		ui.KeyI:         ui.NewKeyAction("Instance Refresh", a.refreshCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Processes", a.processesCmd, true),
+		ui.KeyA:         ui.NewKeyAction("Activities", a.activitiesCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Instances", a.enterCmd, false),
+	})
+}
+
+func (a *Asg) session() (awsV2.Config, bool) {
+	cfg, ok := a.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		a.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	return cfg, ok
+}
+
+func (a *Asg) desiredCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := a.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	cfg, ok := a.session()
+	if !ok {
+		return nil
+	}
+	desired := a.GetTable().GetSelectedCell(2)
+	fields := []dialog.FormField{{Label: "Desired Capacity:", Value: desired}}
+	msg := fmt.Sprintf("Set desired capacity of %s (min %s, max %s)", name, a.GetTable().GetSelectedCell(1), a.GetTable().GetSelectedCell(3))
+	dialog.ShowForm(a.App().Content.Pages, "set desired", msg, fields, func(values []string) error {
+		count, err := strconv.ParseInt(strings.TrimSpace(values[0]), 10, 32)
+		if err != nil || count < 0 {
+			return fmt.Errorf("invalid desired capacity %q", values[0])
+		}
+		if err := aws.SetAsgDesiredCapacity(cfg, name, int32(count)); err != nil {
+			return err
+		}
+		a.App().Flash().Infof("Desired capacity of %s set to %d", name, count)
+		a.Start()
+		return nil
+	}, func() {})
+	return nil
+}
+
+func (a *Asg) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := a.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	cfg, ok := a.session()
+	if !ok {
+		return nil
+	}
+	fields := []dialog.FormField{
+		{Label: "Action:", Options: []string{refreshStart, refreshCancel}},
+		{Label: "Min Healthy %:", Value: "90"},
+	}
+	dialog.ShowForm(a.App().Content.Pages, "instance refresh", name, fields, func(values []string) error {
+		if values[0] == refreshCancel {
+			id, err := aws.CancelInstanceRefresh(cfg, name)
+			if err != nil {
+				return err
+			}
+			a.App().Flash().Infof("Instance refresh %s of %s cancelled", id, name)
+			return nil
+		}
+		pct, err := strconv.ParseInt(strings.TrimSpace(values[1]), 10, 32)
+		if err != nil || pct < 0 || pct > 100 {
+			return fmt.Errorf("invalid min healthy percentage %q", values[1])
+		}
+		id, err := aws.StartInstanceRefresh(cfg, name, int32(pct))
+		if err != nil {
+			return err
+		}
+		a.App().Flash().Infof("Instance refresh %s of %s started", id, name)
+		return nil
+	}, func() {})
+	return nil
+}
+
+func (a *Asg) processesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := a.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	cfg, ok := a.session()
+	if !ok {
+		return nil
+	}
+	fields := []dialog.FormField{
+		{Label: "Action:", Options: []string{processSuspend, processResume}},
+		{Label: "Processes:", Value: a.GetTable().GetSelectedCell(7)},
+	}
+	msg := fmt.Sprintf("Comma separated processes among %s, or %s", strings.Join(aws.AsgProcesses, ","), processAll)
+	dialog.ShowForm(a.App().Content.Pages, "processes "+name, msg, fields, func(values []string) error {
+		processes, err := parseProcesses(values[1])
+		if err != nil {
+			return err
+		}
+		action, f := "suspended", aws.SuspendAsgProcesses
+		if values[0] == processResume {
+			action, f = "resumed", aws.ResumeAsgProcesses
+		}
+		names := strings.Join(processes, ",")
+		if len(processes) == 0 {
+			names = strings.Join(aws.AsgProcesses, ",")
+		}
+		msg := fmt.Sprintf("About to %s processes %s of %s", values[0], names, name)
+		dialog.ShowConfirm(a.App().Content.Pages, values[0]+" processes", msg, func() {
+			if err := f(cfg, name, processes); err != nil {
+				a.App().Flash().Err(err)
+				return
+			}
+			a.App().Flash().Infof("Processes %s of %s %s", names, name, action)
+			a.Start()
+		}, func() {})
+		return nil
+	}, func() {})
+	return nil
+}
+
+// parseProcesses returns the processes of a comma separated list, none
+// meaning all of them. An empty list is rejected so that all the processes
+// are only affected when asked for explicitly.
+func parseProcesses(input string) ([]string, error) {
+	if strings.EqualFold(strings.TrimSpace(input), processAll) {
+		return nil, nil
+	}
+	var processes []string
+	for _, p := range strings.Split(input, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !isAsgProcess(p) {
+			return nil, fmt.Errorf("unknown process %q", p)
+		}
+		processes = append(processes, p)
+	}
+	if len(processes) == 0 {
+		return nil, fmt.Errorf("no processes given, enter %s to affect all of them", processAll)
+	}
+	return processes, nil
+}
+
+func isAsgProcess(name string) bool {
+	for _, p := range aws.AsgProcesses {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Asg) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := a.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	instancesScreen := NewAsgInstances(name)
+	a.App().SetContext(context.WithValue(a.App().GetContext(), internal.ASGName, name))
+	a.App().inject(instancesScreen)
+	instancesScreen.GetTable().SetTitle(fmt.Sprintf(" asg://%s ", name))
+	a.App().Flash().Infof("Viewing %s instances...", name)
+	return nil
+}
+
+func (a *Asg) activitiesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := a.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	activitiesScreen := NewAsgActivities(name)
+	a.App().SetContext(context.WithValue(a.App().GetContext(), internal.ASGName, name))
+	a.App().inject(activitiesScreen)
+	activitiesScreen.GetTable().SetTitle(fmt.Sprintf(" asg://%s/activities ", name))
+	a.App().Flash().Infof("Viewing %s scaling activities...", name)
+	return nil
+}
+
+type AsgInstances struct {
+	name string
+	ResourceViewer
+}
+
+func NewAsgInstances(asg string) ResourceViewer {
+	var a AsgInstances
+	a.name = asg
+	a.ResourceViewer = NewBrowser(internal.LowercaseAsgInstances)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *AsgInstances) Name() string {
+	return a.name
+}
+
+func (a *AsgInstances) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftL:    ui.NewKeyAction("Sort Lifecycle-State", a.GetTable().SortColCmd("Lifecycle-State", true), true),
+		ui.KeyShiftH:    ui.NewKeyAction("Sort Health-Status", a.GetTable().SortColCmd("Health-Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Instance"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(a, "Instance"), false),
+	})
+}
+
+type AsgActivities struct {
+	name string
+	ResourceViewer
+}
+
+func NewAsgActivities(asg string) ResourceViewer {
+	var a AsgActivities
+	a.name = asg
+	a.ResourceViewer = NewBrowser(internal.LowercaseAsgActivities)
+	a.AddBindKeysFn(a.bindKeys)
+	return &a
+}
+
+func (a *AsgActivities) Name() string {
+	return a.name
+}
+
+func (a *AsgActivities) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Start-Time", a.GetTable().SortColCmd("Start-Time", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(a, "Activity"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(a, "Activity"), false),
+	})
+}
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAsg(t *testing.T) {
+	asg := NewAsg("asg")
+	assert.Nil(t, asg.Init(makeCtx()))
+	assert.Equal(t, "asg", asg.Name())
+	assert.Equal(t, 12, len(asg.Hints()))
+}
+
+func TestNewAsgInstances(t *testing.T) {
+	instances := NewAsgInstances("web")
+	assert.Nil(t, instances.Init(makeCtx()))
+	assert.Equal(t, "web", instances.Name())
+	assert.Equal(t, 8, len(instances.Hints()))
+}
+
+func TestNewAsgActivities(t *testing.T) {
+	activities := NewAsgActivities("web")
+	assert.Nil(t, activities.Init(makeCtx()))
+	assert.Equal(t, "web", activities.Name())
+	assert.Equal(t, 7, len(activities.Hints()))
+}
+
+func TestParseProcesses(t *testing.T) {
+	uu := map[string]struct {
+		input     string
+		processes []string
+		err       bool
+	}{
+		"list":    {input: " Launch, Terminate ,", processes: []string{"Launch", "Terminate"}},
+		"all":     {input: " ALL "},
+		"empty":   {input: " , ", err: true},
+		"unknown": {input: "Launch,Reboot", err: true},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			processes, err := parseProcesses(u.input)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.processes, processes)
+		})
+	}
+}
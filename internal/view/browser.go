@@ -102,6 +102,7 @@ func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	b.ClearFilter()
 	b.Refresh()
 	return evt
 }
@@ -3,8 +3,8 @@ package view
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/atotto/clipboard"
 	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
@@ -16,6 +16,7 @@ import (
 type S3FileViewer struct {
 	name, path string
 	ResourceViewer
+	objectDownloads
 }
 
 func NewS3FileViewer(path, resource string) *S3FileViewer {
@@ -35,6 +36,12 @@ func (obj *S3FileViewer) Name() string {
 	return obj.name
 }
 
+// Stop cancels the downloads in progress when leaving the view.
+func (obj *S3FileViewer) Stop() {
+	obj.cancelDownloads()
+	obj.ResourceViewer.Stop()
+}
+
 func (obj *S3FileViewer) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", obj.GetTable().SortColCmd("Name", true), true),
@@ -43,7 +50,7 @@ func (obj *S3FileViewer) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftC:    ui.NewKeyAction("Sort Storage-Class", obj.GetTable().SortColCmd("Storage-Class", true), true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", obj.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", obj.enterCmd, false),
-		tcell.KeyCtrlD:  ui.NewKeyAction("Download Object", obj.downloadCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Download", obj.downloadCmd, true),
 		tcell.KeyCtrlP:  ui.NewKeyAction("Pre-Signed URL", obj.preSignedUrlCmd, true),
 	})
 }
@@ -71,30 +78,23 @@ func (obj *S3FileViewer) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (obj *S3FileViewer) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
-	objName := obj.GetTable().GetSelectedItem()
-	fileType := obj.GetTable().GetSecondColumn()
-
-	if fileType == internal.FILE_TYPE {
-		ctx := obj.App().GetContext()
-		op := getObjectParams(ctx, objName)
-		res := aws.DownloadObject(op.cfg, op.bucketName, op.key)
-		obj.App().Flash().Info(res)
-	}
+	op := getObjectParams(obj.App().GetContext(), "")
+	src := aws.NewS3Source(op.cfg, op.bucketName)
+	items := selectedObjects(obj.GetTable())
+	downloadObjects(obj.downloadContext(), obj.App(), obj.GetTable(), src, op.key, items, func() {})
 
 	return nil
 }
 
 func (obj *S3FileViewer) preSignedUrlCmd(evt *tcell.EventKey) *tcell.EventKey {
-	objNmae := obj.GetTable().GetSelectedItem()
+	objName := obj.GetTable().GetSelectedItem()
 	fileType := obj.GetTable().GetSecondColumn()
 
 	if fileType == internal.FILE_TYPE {
-		ctx := obj.App().GetContext()
-		op := getObjectParams(ctx, objNmae)
-		url := aws.GetPreSignedUrl(op.cfg, op.bucketName, op.key)
-		log.Info().Msg(fmt.Sprintf("In view Presigned URL: %v", url))
-		clipboard.WriteAll(url)
-		obj.App().Flash().Info("Presigned URL Copied to Clipboard.")
+		op := getObjectParams(obj.App().GetContext(), objName)
+		showPresign(obj.App(), objName, defaultPresignExpiry, aws.S3MaxPresignExpiry, func(method string, expiry time.Duration) (string, error) {
+			return aws.GetPreSignedUrl(op.cfg, op.bucketName, op.key, method, expiry)
+		})
 	}
 
 	return nil
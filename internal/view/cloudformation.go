@@ -0,0 +1,289 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/render"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+// cfnResourceViews maps CloudFormation resource types to cloudlens resources.
+var cfnResourceViews = map[string]string{
+	"AWS::AutoScaling::AutoScalingGroup":        internal.LowercaseAsg,
+	"AWS::EC2::Instance":                        internal.LowercaseEc2,
+	"AWS::EC2::SecurityGroup":                   internal.LowercaseSg,
+	"AWS::EC2::VPC":                             internal.LowercaseVPC,
+	"AWS::EC2::Volume":                          internal.LowercaseEBS,
+	"AWS::ECR::Repository":                      internal.LowercaseEcr,
+	"AWS::ECS::Cluster":                         internal.LowercaseEcsCluster,
+	"AWS::ECS::TaskDefinition":                  internal.LowercaseEcsTaskDefinitions,
+	"AWS::EKS::Cluster":                         internal.LowercaseEks,
+	"AWS::ElasticLoadBalancing::LoadBalancer":   internal.LowercaseElb,
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": internal.LowercaseElb,
+	"AWS::IAM::Group":                           internal.LowercaseIamGroup,
+	"AWS::IAM::Role":                            internal.LowercaseIamRole,
+	"AWS::IAM::User":                            internal.LowercaseIamUser,
+	"AWS::Lambda::Function":                     internal.LowercaseLamda,
+	"AWS::Route53::HostedZone":                  internal.LowercaseR53,
+	"AWS::S3::Bucket":                           internal.LowercaseS3,
+	"AWS::SQS::Queue":                           internal.LowercaseSQS,
+}
+
+type Cfn struct {
+	ResourceViewer
+}
+
+func NewCfn(resource string) ResourceViewer {
+	var c Cfn
+	c.ResourceViewer = NewBrowser(resource)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *Cfn) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyShiftU:    ui.NewKeyAction("Sort Updated", c.GetTable().SortColCmd("Updated", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(c, "Stack"), true),
+		ui.KeyE:         ui.NewKeyAction("Events", c.eventsCmd, true),
+		ui.KeyO:         ui.NewKeyAction("Outputs", c.outputsCmd, true),
+		ui.KeyF:         ui.NewKeyAction("Detect Drift", c.detectDriftCmd, true),
+		ui.KeyShiftF:    ui.NewKeyAction("Drift Diff", c.driftCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Resources", c.enterCmd, false),
+	})
+}
+
+func (c *Cfn) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stack := c.GetTable().GetSelectedItem()
+	if stack == "" {
+		return nil
+	}
+	resourcesScreen := NewCfnResources(stack)
+	c.App().SetContext(context.WithValue(c.App().GetContext(), internal.CFNStackName, stack))
+	c.App().inject(resourcesScreen)
+	resourcesScreen.GetTable().SetTitle(fmt.Sprintf(" cfn://%s ", stack))
+	c.App().Flash().Infof("Viewing %s resources...", stack)
+	return nil
+}
+
+func (c *Cfn) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stack := c.GetTable().GetSelectedItem()
+	if stack == "" {
+		return nil
+	}
+	eventsScreen := NewCfnEvents(stack)
+	c.App().SetContext(context.WithValue(c.App().GetContext(), internal.CFNStackName, stack))
+	c.App().inject(eventsScreen)
+	eventsScreen.GetTable().SetTitle(fmt.Sprintf(" cfn://%s/events ", stack))
+	c.App().Flash().Infof("Viewing %s events...", stack)
+	return nil
+}
+
+func (c *Cfn) outputsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stack := c.GetTable().GetSelectedItem()
+	if stack == "" {
+		return nil
+	}
+	cfg, ok := c.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		c.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	text, err := aws.GetStackOutputs(cfg, stack)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	v := NewLiveView(c.App(), "Outputs", model.NewText(stack, text))
+	if err := c.App().inject(v); err != nil {
+		c.App().Flash().Err(err)
+	}
+	return nil
+}
+
+func (c *Cfn) detectDriftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stack := c.GetTable().GetSelectedItem()
+	if stack == "" {
+		return nil
+	}
+	cfg, ok := c.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		c.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	msg := fmt.Sprintf("Detect drift of stack %s?", stack)
+	dialog.ShowConfirm(c.App().Content.Pages, "detect drift", msg, func() {
+		id, err := aws.DetectStackDrift(cfg, stack)
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Drift detection %s of %s started", id, stack)
+		c.Start()
+	}, func() {})
+	return nil
+}
+
+func (c *Cfn) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stack := c.GetTable().GetSelectedItem()
+	if stack == "" {
+		return nil
+	}
+	showStackDrift(c.App(), stack, "")
+	return nil
+}
+
+func showStackDrift(app *App, stack, logicalId string) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	text, err := aws.GetStackResourceDrifts(cfg, stack, logicalId)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	path := stack
+	if logicalId != "" {
+		path += "/" + logicalId
+	}
+	v := NewLiveView(app, "Drift", model.NewText(path, text))
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+type CfnResources struct {
+	name string
+	ResourceViewer
+}
+
+func NewCfnResources(stack string) ResourceViewer {
+	var c CfnResources
+	c.name = stack
+	c.ResourceViewer = NewBrowser(internal.LowercaseCfnResources)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *CfnResources) Name() string {
+	return c.name
+}
+
+func (c *CfnResources) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", c.GetTable().SortColCmd("Type", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(c, "Resource"), true),
+		ui.KeyShiftF:    ui.NewKeyAction("Drift Diff", c.driftCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", c.enterCmd, false),
+	})
+}
+
+func (c *CfnResources) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	logicalId := c.GetTable().GetSelectedItem()
+	if logicalId == "" {
+		return nil
+	}
+	showStackDrift(c.App(), c.name, logicalId)
+	return nil
+}
+
+// enterCmd opens the cloudlens view of the selected resource filtered on its
+// physical id, falling back to describing it.
+func (c *CfnResources) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	logicalId := c.GetTable().GetSelectedItem()
+	if logicalId == "" {
+		return nil
+	}
+	resType, physicalId := c.GetTable().GetSelectedCell(2), c.GetTable().GetSelectedCell(1)
+	res, ok := cfnResourceViews[resType]
+	if !ok || physicalId == "" {
+		return describeSelected(c, "Resource")(evt)
+	}
+	meta, ok := customViewers[res]
+	if !ok {
+		return describeSelected(c, "Resource")(evt)
+	}
+	v := meta.viewerFn(res)
+	if err := c.App().inject(v); err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	v.GetTable().Filter(regexp.QuoteMeta(cfnFilterTerm(resType, physicalId)))
+	c.App().Flash().Infof("Viewing %s %s...", res, physicalId)
+	return nil
+}
+
+// cfnFilterTerm returns the part of a physical id shown by cloudlens views,
+// i.e the name of a load balancer arn or of a queue url.
+func cfnFilterTerm(resType, physicalId string) string {
+	switch resType {
+	case "AWS::ElasticLoadBalancingV2::LoadBalancer":
+		// arn:aws:elasticloadbalancing:region:account:loadbalancer/app/name/id
+		if parts := strings.Split(physicalId, "/"); len(parts) >= 3 {
+			return parts[len(parts)-2]
+		}
+	case "AWS::ECS::TaskDefinition":
+		// arn:aws:ecs:region:account:task-definition/family:revision
+		family := physicalId[strings.LastIndex(physicalId, "/")+1:]
+		if i := strings.LastIndex(family, ":"); i > 0 {
+			return family[:i]
+		}
+		return family
+	case "AWS::SQS::Queue", "AWS::ECS::Cluster":
+		return physicalId[strings.LastIndex(physicalId, "/")+1:]
+	}
+	return physicalId
+}
+
+type CfnEvents struct {
+	name string
+	ResourceViewer
+}
+
+func NewCfnEvents(stack string) ResourceViewer {
+	var c CfnEvents
+	c.name = stack
+	c.ResourceViewer = NewBrowser(internal.LowercaseCfnEvents)
+	c.GetTable().SetColorerFn(render.StackEvents{}.ColorerFunc())
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *CfnEvents) Name() string {
+	return c.name
+}
+
+func (c *CfnEvents) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Timestamp", c.GetTable().SortColCmd("Timestamp", false), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", c.describeCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", c.describeCmd, false),
+	})
+}
+
+// describeCmd describes the selected event, rows being keyed by event id.
+func (c *CfnEvents) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	eventId, ok := c.GetTable().GetRowID(c.GetTable().GetSelectedRowIndex())
+	if !ok || eventId == "" {
+		return nil
+	}
+	describeResource(c.App(), c.GetTable().GetModel(), c.Resource(), eventId)
+	c.App().Flash().Infof("Event %s of %s", c.GetTable().GetSelectedCell(1), c.GetTable().GetSelectedItem())
+	return nil
+}
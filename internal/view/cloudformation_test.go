@@ -0,0 +1,48 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCfn(t *testing.T) {
+	cfn := NewCfn("cfn")
+	assert.Nil(t, cfn.Init(makeCtx()))
+	assert.Equal(t, "cfn", cfn.Name())
+	assert.Equal(t, 12, len(cfn.Hints()))
+}
+
+func TestNewCfnResources(t *testing.T) {
+	resources := NewCfnResources("web")
+	assert.Nil(t, resources.Init(makeCtx()))
+	assert.Equal(t, "web", resources.Name())
+	assert.Equal(t, 9, len(resources.Hints()))
+}
+
+func TestNewCfnEvents(t *testing.T) {
+	events := NewCfnEvents("web")
+	assert.Nil(t, events.Init(makeCtx()))
+	assert.Equal(t, "web", events.Name())
+	assert.Equal(t, 8, len(events.Hints()))
+}
+
+func TestCfnFilterTerm(t *testing.T) {
+	uu := map[string]struct {
+		resType, physicalId, want string
+	}{
+		"instance": {"AWS::EC2::Instance", "i-0abc", "i-0abc"},
+		"queue":    {"AWS::SQS::Queue", "https://sqs.us-east-1.amazonaws.com/123/jobs", "jobs"},
+		"elbv2":    {"AWS::ElasticLoadBalancingV2::LoadBalancer", "arn:aws:elasticloadbalancing:us-east-1:123:loadbalancer/app/web/50dc6c495c0c9188", "web"},
+		"taskdef":  {"AWS::ECS::TaskDefinition", "arn:aws:ecs:us-east-1:123:task-definition/web:7", "web"},
+		"cluster":  {"AWS::ECS::Cluster", "arn:aws:ecs:us-east-1:123:cluster/prod", "prod"},
+		"bucket":   {"AWS::S3::Bucket", "my-bucket", "my-bucket"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.want, cfnFilterTerm(u.resType, u.physicalId))
+		})
+	}
+}
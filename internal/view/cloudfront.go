@@ -0,0 +1,152 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+const (
+	invalidationPollInterval = 10 * time.Second
+	invalidationTimeout      = 30 * time.Minute
+)
+
+type Cf struct {
+	ResourceViewer
+}
+
+func NewCf(resource string) ResourceViewer {
+	var c Cf
+	c.ResourceViewer = NewBrowser(resource)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *Cf) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD:    ui.NewKeyAction("Sort Domain", c.GetTable().SortColCmd("Domain", true), true),
+		ui.KeyShiftA:    ui.NewKeyAction("Sort Aliases", c.GetTable().SortColCmd("Aliases", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyI:         ui.NewKeyAction("Invalidate", c.invalidateCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(c, "Distribution"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Cache Behaviors", c.enterCmd, false),
+	})
+}
+
+func (c *Cf) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := c.GetTable().GetSelectedItem()
+	if id == "" {
+		return nil
+	}
+	behaviorsScreen := NewCfBehaviors(id)
+	c.App().SetContext(context.WithValue(c.App().GetContext(), internal.CFDistributionId, id))
+	c.App().inject(behaviorsScreen)
+	behaviorsScreen.GetTable().SetTitle(fmt.Sprintf(" cf://%s ", id))
+	c.App().Flash().Infof("Viewing %s cache behaviors...", id)
+	return nil
+}
+
+func (c *Cf) invalidateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := c.GetTable().GetSelectedItem()
+	if id == "" {
+		return nil
+	}
+	showInvalidation(c.App(), id, "/*")
+	return nil
+}
+
+type CfBehaviors struct {
+	id string
+	ResourceViewer
+}
+
+func NewCfBehaviors(id string) ResourceViewer {
+	var c CfBehaviors
+	c.id = id
+	c.ResourceViewer = NewBrowser(internal.LowercaseCfBehaviors)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *CfBehaviors) Name() string {
+	return c.id
+}
+
+func (c *CfBehaviors) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO:    ui.NewKeyAction("Sort Origin", c.GetTable().SortColCmd("Origin", true), true),
+		ui.KeyI:         ui.NewKeyAction("Invalidate", c.invalidateCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(c, "Cache Behavior"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(c, "Cache Behavior"), false),
+	})
+}
+
+// invalidateCmd prompts for an invalidation of the paths matched by the
+// selected cache behavior.
+func (c *CfBehaviors) invalidateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	pattern := c.GetTable().GetSelectedItem()
+	if pattern == "" {
+		return nil
+	}
+	if pattern == aws.DefaultPathPattern {
+		pattern = "/*"
+	}
+	showInvalidation(c.App(), c.id, pattern)
+	return nil
+}
+
+// showInvalidation prompts for the paths of a distribution to invalidate,
+// then tracks the invalidation until it completes.
+func showInvalidation(app *App, id, paths string) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	fields := []dialog.FormField{
+		{Label: "Paths:", Value: paths},
+	}
+	msg := fmt.Sprintf("Paths of %s to invalidate, comma or space separated", id)
+	dialog.ShowForm(app.Content.Pages, "invalidate", msg, fields, func(values []string) error {
+		items := aws.InvalidationPaths(values[0])
+		if len(items) == 0 {
+			return fmt.Errorf("at least one path is required")
+		}
+		invalidation, err := aws.CreateInvalidation(cfg, id, items)
+		if err != nil {
+			return err
+		}
+		app.Flash().Infof("Invalidation %s of %s created...", invalidation, id)
+		go trackInvalidation(app, cfg, id, invalidation)
+		return nil
+	}, func() {})
+}
+
+// trackInvalidation polls an invalidation, flashing its status until it
+// completes.
+func trackInvalidation(app *App, cfg awsV2.Config, id, invalidation string) {
+	deadline := time.Now().Add(invalidationTimeout)
+	for time.Now().Before(deadline) {
+		<-time.After(invalidationPollInterval)
+		status, err := aws.GetInvalidationStatus(cfg, id, invalidation)
+		if err != nil {
+			app.Flash().Errf("Unable to get invalidation %s of %s: %v", invalidation, id, err)
+			return
+		}
+		if status == aws.InvalidationCompleted {
+			app.Flash().Infof("Invalidation %s of %s completed", invalidation, id)
+			return
+		}
+		app.Flash().Infof("Invalidation %s of %s %s...", invalidation, id, status)
+	}
+	app.Flash().Warnf("Invalidation %s of %s still in progress after %v", invalidation, id, invalidationTimeout)
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCf(t *testing.T) {
+	cf := NewCf("cf")
+	assert.Nil(t, cf.Init(makeCtx()))
+	assert.Equal(t, "cf", cf.Name())
+	assert.Equal(t, 10, len(cf.Hints()))
+}
+
+func TestNewCfBehaviors(t *testing.T) {
+	behaviors := NewCfBehaviors("E2QWRUHAPOMQZL")
+	assert.Nil(t, behaviors.Init(makeCtx()))
+	assert.Equal(t, "E2QWRUHAPOMQZL", behaviors.Name())
+	assert.Equal(t, 8, len(behaviors.Hints()))
+}
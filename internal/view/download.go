@@ -0,0 +1,140 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/atotto/clipboard"
+	"github.com/dustin/go-humanize"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/one2nc/cloudlens/internal/download"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// objectItem represents a marked or selected row of an object browser.
+type objectItem struct {
+	name   string
+	folder bool
+}
+
+// selectedObjects returns the marked rows of an object browser or the
+// current selection if nothing is marked.
+func selectedObjects(t *Table) []objectItem {
+	var items []objectItem
+	for r := 1; r < t.GetRowCount(); r++ {
+		name, kind := t.GetCell(r, 0), t.GetCell(r, 1)
+		if name == nil || kind == nil || !t.IsMarked(name.Text) {
+			continue
+		}
+		items = append(items, objectItem{name: name.Text, folder: kind.Text == internal.FOLDER_TYPE})
+	}
+	if len(items) > 0 {
+		return items
+	}
+	if name := t.GetSelectedItem(); name != "" {
+		items = append(items, objectItem{name: name, folder: t.GetSecondColumn() == internal.FOLDER_TYPE})
+	}
+
+	return items
+}
+
+// objectDownloads cancels the downloads started from an object browser once
+// it stops, i.e. when leaving it on escape or for another view.
+type objectDownloads struct {
+	mx     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (d *objectDownloads) downloadContext() context.Context {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	if d.ctx == nil {
+		d.ctx, d.cancel = context.WithCancel(context.Background())
+	}
+	return d.ctx
+}
+
+func (d *objectDownloads) cancelDownloads() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	if d.cancel != nil {
+		d.cancel()
+		d.ctx, d.cancel = nil, nil
+	}
+}
+
+// downloadObjects prompts for a destination and downloads the given items
+// found under prefix, recursing into folders and keeping relative paths.
+func downloadObjects(ctx context.Context, app *App, t *Table, src download.Source, prefix string, items []objectItem, done func()) {
+	if len(items) == 0 {
+		done()
+		return
+	}
+	cl := app.Config().Cloudlens
+	dialog.ShowPrompt(app.Content.Pages, "download", "Destination:", cl.GetDownloadDir(), func(dest string) {
+		dest = config.ExpandHome(strings.TrimSpace(dest))
+		if dest == "" {
+			app.Flash().Warn("Download destination can not be empty")
+			done()
+			return
+		}
+		t.ClearMarks()
+		go func() {
+			defer done()
+			runDownload(ctx, app, src, prefix, items, dest, cl.GetDownloadWorkers())
+		}()
+	}, done)
+}
+
+func runDownload(ctx context.Context, app *App, src download.Source, prefix string, items []objectItem, dest string, workers int) {
+	app.Flash().Info("Listing objects to download...")
+	var objs []download.Object
+	for _, it := range items {
+		key := prefix + it.name
+		if it.folder && !strings.HasSuffix(key, "/") {
+			key += "/"
+		}
+		oo, err := src.List(ctx, key)
+		if ctx.Err() != nil {
+			app.Flash().Warn("Download canceled")
+			return
+		}
+		if err != nil {
+			app.Flash().Errf("Unable to list %q: %v", key, err)
+			return
+		}
+		for _, o := range oo {
+			if !it.folder && o.Key != key {
+				continue
+			}
+			o.Path = strings.TrimPrefix(o.Key, prefix)
+			objs = append(objs, o)
+		}
+	}
+	if len(objs) == 0 {
+		app.Flash().Warn("No objects to download")
+		return
+	}
+
+	d := download.NewDownloader(src, dest, workers)
+	d.SetProgressFn(func(done, total int) {
+		app.Flash().Infof("Downloading objects %d/%d...", done, total)
+	})
+	res := d.Run(ctx, objs)
+	log.Info().Msg(fmt.Sprintf("Downloaded %d objects to %v, skipped %d, failed %d", res.Downloaded, dest, res.Skipped, len(res.Errors)))
+	if ctx.Err() != nil {
+		app.Flash().Warnf("Download canceled, %d of %d objects downloaded", res.Downloaded, len(objs))
+		return
+	}
+	if len(res.Errors) > 0 {
+		app.Flash().Errf("%d of %d objects failed to download, first error: %v", len(res.Errors), len(objs), res.Errors[0])
+		return
+	}
+	clipboard.WriteAll(dest)
+	app.Flash().Infof("%d objects (%s) downloaded, %d up to date, destination path copied to the clipboard", res.Downloaded, humanize.Bytes(uint64(res.Bytes)), res.Skipped)
+}
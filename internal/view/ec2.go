@@ -1,8 +1,13 @@
 package view
 
 import (
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/config"
 	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
 )
 
 type EC2 struct {
@@ -27,11 +32,51 @@ func (e *EC2) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftL:    ui.NewKeyAction("Sort Launch-Time", e.GetTable().SortColCmd("Launch-Time", true), true),
 		ui.KeyShiftM:    ui.NewKeyAction("Sort Monitoring-State", e.GetTable().SortColCmd("Monitoring-State", true), true),
 		ui.KeyShiftP:    ui.NewKeyAction("Sort Public-DNS", e.GetTable().SortColCmd("Public-DNS", true), false),
+		ui.KeyS:         ui.NewKeyAction("Shell", e.shellCmd, true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", e.enterCmd, false),
 	})
 }
 
+func (e *EC2) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	instanceId := e.GetTable().GetSelectedItem()
+	if instanceId == "" {
+		return nil
+	}
+	cfg, ok := e.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		e.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	cl := e.App().Config().Cloudlens
+	fields := []dialog.FormField{
+		{Label: "Method:", Options: []string{shellSSM, shellSSH}},
+		{Label: "SSH User:", Value: cl.GetSSHUser()},
+		{Label: "SSH Key:", Value: cl.GetSSHKey()},
+	}
+	dialog.ShowForm(e.App().Content.Pages, "shell", instanceId, fields, func(values []string) error {
+		var (
+			cmd *aws.ShellCommand
+			err error
+		)
+		if values[0] == shellSSH {
+			var host string
+			if host, err = aws.GetInstanceAddress(cfg, instanceId); err != nil {
+				return err
+			}
+			cmd, err = sshCommand(values[1], config.ExpandHome(values[2]), host)
+		} else {
+			cmd, err = aws.SSMSessionCommand(cfg, activeProfile(e.App()), instanceId)
+		}
+		if err != nil {
+			return err
+		}
+		runShell(e.App(), instanceId, cmd)
+		return nil
+	}, func() {})
+	return nil
+}
+
 func (e *EC2) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	instanceId := e.GetTable().GetSelectedItem()
 	if instanceId != "" {
@@ -10,5 +10,5 @@ func TestNewEc2(t *testing.T) {
 	ec2 := NewEC2("ec2")
 	assert.Nil(t, ec2.Init(makeCtx()))
 	assert.Equal(t, "ec2", ec2.Name())
-	assert.Equal(t, 11, len(ec2.Hints()))
+	assert.Equal(t, 13, len(ec2.Hints()))
 }
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atotto/clipboard"
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/dao"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+type Ecr struct {
+	ResourceViewer
+}
+
+func NewEcr(resource string) ResourceViewer {
+	var e Ecr
+	e.ResourceViewer = NewBrowser(resource)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *Ecr) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftI:    ui.NewKeyAction("Sort Images", e.GetTable().SortColCmd("Images", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Repository"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", e.enterCmd, false),
+	})
+}
+
+func (e *Ecr) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	repo := e.GetTable().GetSelectedItem()
+	if repo == "" {
+		return nil
+	}
+	imagesScreen := NewEcrImages(repo)
+	ctx := context.WithValue(e.App().GetContext(), internal.ECRRepositoryName, repo)
+	ctx = context.WithValue(ctx, internal.ECRRepositoryUri, e.GetTable().GetSelectedCell(1))
+	e.App().SetContext(ctx)
+	e.App().inject(imagesScreen)
+	imagesScreen.GetTable().SetTitle(fmt.Sprintf(" ecr://%s ", repo))
+	e.App().Flash().Infof("Viewing %s images...", repo)
+	return nil
+}
+
+type EcrImages struct {
+	name string
+	ResourceViewer
+}
+
+func NewEcrImages(repo string) ResourceViewer {
+	var e EcrImages
+	e.name = repo
+	e.ResourceViewer = NewBrowser(internal.LowercaseEcrImages)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EcrImages) Name() string {
+	return e.name
+}
+
+func (e *EcrImages) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP:    ui.NewKeyAction("Sort Pushed-At", e.GetTable().SortColCmd("Pushed-At", false), true),
+		ui.KeyD:         ui.NewKeyAction("Findings", describeSelected(e, "Image"), true),
+		ui.KeyC:         ui.NewKeyAction("Copy Pull Command", e.copyPullCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Delete Untagged", e.deleteUntaggedCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(e, "Image"), false),
+	})
+}
+
+func (e *EcrImages) copyPullCmd(evt *tcell.EventKey) *tcell.EventKey {
+	digest := e.GetTable().GetSelectedItem()
+	if digest == "" {
+		return nil
+	}
+	repoUri, _ := e.App().GetContext().Value(internal.ECRRepositoryUri).(string)
+	cmd := aws.DockerPullCommand(repoUri, e.GetTable().GetSelectedCell(1), digest)
+	if err := clipboard.WriteAll(cmd); err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	e.App().Flash().Infof("%s copied to the clipboard", cmd)
+	return nil
+}
+
+func (e *EcrImages) deleteUntaggedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg, ok := e.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		e.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	msg := fmt.Sprintf("Delete all untagged images of %s?", e.name)
+	dialog.ShowConfirm(e.App().Content.Pages, "delete untagged", msg, func() {
+		n, err := aws.DeleteUntaggedEcrImages(cfg, e.name)
+		if d, ok := model.Registry[internal.LowercaseEcr].DAO.(*dao.ECRRepositories); ok {
+			repoUri, _ := e.App().GetContext().Value(internal.ECRRepositoryUri).(string)
+			d.ForgetImageCount(repoUri)
+		}
+		e.Start()
+		if err != nil {
+			e.App().Flash().Errf("Deleted %d untagged images: %v", n, err)
+			return
+		}
+		e.App().Flash().Infof("Deleted %d untagged images", n)
+	}, func() {})
+	return nil
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcr(t *testing.T) {
+	ecr := NewEcr("ecr")
+	assert.Nil(t, ecr.Init(makeCtx()))
+	assert.Equal(t, "ecr", ecr.Name())
+	assert.Equal(t, 7, len(ecr.Hints()))
+}
+
+func TestNewEcrImages(t *testing.T) {
+	images := NewEcrImages("web")
+	assert.Nil(t, images.Init(makeCtx()))
+	assert.Equal(t, "web", images.Name())
+	assert.Equal(t, 9, len(images.Hints()))
+}
@@ -1,9 +1,17 @@
 package view
 
 import (
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
 	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
 )
 
 type EcsContainer struct {
@@ -26,6 +34,8 @@ func (ecs *EcsContainer) Name() string {
 func (ecs *EcsContainer) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyD:         ui.NewKeyAction("Describe", ecs.describeEcsContainer, true),
+		ui.KeyS:         ui.NewKeyAction("Shell", ecs.shellCmd, true),
+		ui.KeyL:         ui.NewKeyAction("Logs", ecs.logsCmd, true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", ecs.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", ecs.enterCmd, false),
 	})
@@ -48,3 +58,77 @@ func (ecs *EcsContainer) describeEcsContainer(evt *tcell.EventKey) *tcell.EventK
 	ecs.App().Flash().Infof("Container %s", containerId)
 	return nil
 }
+
+func (ecs *EcsContainer) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	containerName, runtimeId := ecs.GetTable().GetSelectedItem(), ecs.GetTable().GetSelectedCell(1)
+	if containerName == "" || runtimeId == "" {
+		return nil
+	}
+	ctx := ecs.App().GetContext()
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		ecs.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	clusterName, _ := ctx.Value(internal.ECSClusterName).(string)
+	fields := []dialog.FormField{{Label: "Command:", Value: aws.DefaultShellCommand}}
+	msg := fmt.Sprintf("ECS exec into %s of task %s", containerName, ecs.name)
+	dialog.ShowForm(ecs.App().Content.Pages, "shell", msg, fields, func(values []string) error {
+		command := strings.TrimSpace(values[0])
+		if command == "" {
+			return fmt.Errorf("command can not be empty")
+		}
+		cmd, err := aws.EcsExecCommand(cfg, activeProfile(ecs.App()), clusterName, ecs.name, containerName, runtimeId, command)
+		if err != nil {
+			return err
+		}
+		runShell(ecs.App(), containerName, cmd)
+		return nil
+	}, func() {})
+	return nil
+}
+
+func (ecs *EcsContainer) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	containerName := ecs.GetTable().GetSelectedItem()
+	if containerName == "" {
+		return nil
+	}
+	ctx := ecs.App().GetContext()
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		ecs.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	clusterName, _ := ctx.Value(internal.ECSClusterName).(string)
+	stream, err := aws.GetEcsContainerLogStream(cfg, clusterName, ecs.name, containerName)
+	if err != nil {
+		ecs.App().Flash().Err(err)
+		return nil
+	}
+	logsCfg := cfg.Copy()
+	logsCfg.Region = stream.Region
+	events, err := aws.GetLogEvents(logsCfg, stream.Group, stream.Stream, aws.DefaultLogEventsLimit)
+	if err != nil {
+		ecs.App().Flash().Err(err)
+		return nil
+	}
+	v := NewLiveView(ecs.App(), "Logs", model.NewText(stream.Group+"/"+stream.Stream, renderLogEvents(events)))
+	if err := ecs.App().inject(v); err != nil {
+		ecs.App().Flash().Err(err)
+	}
+	ecs.App().Flash().Infof("Showing the last %d events of %s", len(events), stream.Stream)
+	return nil
+}
+
+// renderLogEvents renders log events, escaped so the messages are not taken
+// for color or region tags.
+func renderLogEvents(events []aws.LogEventResp) string {
+	if len(events) == 0 {
+		return "No log events"
+	}
+	var b strings.Builder
+	for _, e := range events {
+		fmt.Fprintf(&b, "%s  %s\n", e.Timestamp, tview.Escape(strings.TrimRight(e.Message, "\n")))
+	}
+	return b.String()
+}
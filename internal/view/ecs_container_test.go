@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcsContainer(t *testing.T) {
+	ecs := NewEcsContainer("task")
+	assert.Nil(t, ecs.Init(makeCtx()))
+	assert.Equal(t, "task", ecs.Name())
+	assert.Equal(t, 8, len(ecs.Hints()))
+}
+
+func TestRenderLogEvents(t *testing.T) {
+	s := renderLogEvents([]aws.LogEventResp{{Timestamp: "Mon Jan  2 15:04:05 2006", Message: "started\n"}})
+
+	assert.Equal(t, "Mon Jan  2 15:04:05 2006  started\n", s)
+	assert.Equal(t, "No log events", renderLogEvents(nil))
+
+	s = renderLogEvents([]aws.LogEventResp{{Timestamp: "Mon Jan  2 15:04:05 2006", Message: `[red]hosts ["db1"]`}})
+	assert.Equal(t, "Mon Jan  2 15:04:05 2006  [red[]hosts [\"db1\"[]\n", s)
+}
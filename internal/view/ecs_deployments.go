@@ -0,0 +1,69 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type EcsDeployments struct {
+	name string
+	ResourceViewer
+}
+
+func NewEcsDeployments(serviceName string) ResourceViewer {
+	var ecs EcsDeployments
+	ecs.name = serviceName
+	ecs.ResourceViewer = NewBrowser(internal.LowercaseEcsDeployments)
+	ecs.AddBindKeysFn(ecs.bindKeys)
+	return &ecs
+}
+
+func (ecs *EcsDeployments) Name() string {
+	return ecs.name
+}
+
+func (ecs *EcsDeployments) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", ecs.GetTable().SortColCmd("Created", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(ecs, "Deployment"), true),
+		ui.KeyE:         ui.NewKeyAction("Events", ecs.eventsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", ecs.App().PrevCmd, false),
+	})
+}
+
+func (ecs *EcsDeployments) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ctx := ecs.App().GetContext()
+	cfg, _ := ctx.Value(internal.KeySession).(awsV2.Config)
+	clusterName, _ := ctx.Value(internal.ECSClusterName).(string)
+	events, err := aws.ListEcsServiceEvents(cfg, clusterName, ecs.name)
+	if err != nil {
+		ecs.App().Flash().Err(err)
+		return nil
+	}
+	v := NewLiveView(ecs.App(), "Events", model.NewText(ecs.name, renderServiceEvents(events)))
+	if err := ecs.App().inject(v); err != nil {
+		ecs.App().Flash().Err(err)
+	}
+	return nil
+}
+
+// renderServiceEvents renders service events, escaped so the messages are not
+// taken for color or region tags.
+func renderServiceEvents(events []aws.EcsServiceEventResp) string {
+	if len(events) == 0 {
+		return "No recent events"
+	}
+	var b strings.Builder
+	for _, e := range events {
+		fmt.Fprintf(&b, "%s  %s\n", e.CreatedAt, tview.Escape(e.Message))
+	}
+	return b.String()
+}
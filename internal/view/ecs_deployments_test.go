@@ -0,0 +1,27 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcsDeployments(t *testing.T) {
+	ecs := NewEcsDeployments("service")
+	assert.Nil(t, ecs.Init(makeCtx()))
+	assert.Equal(t, "service", ecs.Name())
+	assert.Equal(t, 7, len(ecs.Hints()))
+}
+
+func TestRenderServiceEvents(t *testing.T) {
+	s := renderServiceEvents([]aws.EcsServiceEventResp{
+		{CreatedAt: "Mon Jan  2 15:04:05 2006", Message: "(service web) has reached a steady state."},
+	})
+
+	assert.Equal(t, "Mon Jan  2 15:04:05 2006  (service web) has reached a steady state.\n", s)
+	assert.Equal(t, "No recent events", renderServiceEvents(nil))
+
+	s = renderServiceEvents([]aws.EcsServiceEventResp{{CreatedAt: "Mon Jan  2 15:04:05 2006", Message: "[red]failed"}})
+	assert.Equal(t, "Mon Jan  2 15:04:05 2006  [red[]failed\n", s)
+}
@@ -3,10 +3,15 @@ package view
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
 	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
 )
 
 type EcsServices struct {
@@ -29,6 +34,10 @@ func (ecs *EcsServices) Name() string {
 func (ecs *EcsServices) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyD:         ui.NewKeyAction("Describe", ecs.describeEcsService, true),
+		ui.KeyS:         ui.NewKeyAction("Scale", ecs.scaleCmd, true),
+		ui.KeyF:         ui.NewKeyAction("Force Deploy", ecs.forceDeployCmd, true),
+		ui.KeyL:         ui.NewKeyAction("Deployments", ecs.deploymentsCmd, true),
+		ui.KeyT:         ui.NewKeyAction("Task Definition", ecs.taskDefinitionCmd, true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", ecs.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", ecs.enterCmd, false),
 	})
@@ -63,3 +72,80 @@ func (ecs *EcsServices) describeEcsService(evt *tcell.EventKey) *tcell.EventKey
 	ecs.App().Flash().Infof("Service %s", serviceName)
 	return nil
 }
+
+func (ecs *EcsServices) cluster() (awsV2.Config, string) {
+	ctx := ecs.App().GetContext()
+	cfg, _ := ctx.Value(internal.KeySession).(awsV2.Config)
+	clusterName, _ := ctx.Value(internal.ECSClusterName).(string)
+	return cfg, clusterName
+}
+
+func (ecs *EcsServices) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	serviceName := ecs.GetTable().GetSelectedItem()
+	if serviceName == "" {
+		return nil
+	}
+	cfg, clusterName := ecs.cluster()
+	desired := ecs.GetTable().GetSelectedColumn("Desired")
+	fields := []dialog.FormField{{Label: "Desired Count:", Value: desired}}
+	msg := fmt.Sprintf("Scale service %s (currently %s)", serviceName, desired)
+	dialog.ShowForm(ecs.App().Content.Pages, "scale", msg, fields, func(values []string) error {
+		count, err := strconv.ParseInt(strings.TrimSpace(values[0]), 10, 32)
+		if err != nil || count < 0 {
+			return fmt.Errorf("invalid desired count %q", values[0])
+		}
+		if err := aws.ScaleEcsService(cfg, clusterName, serviceName, int32(count)); err != nil {
+			return err
+		}
+		ecs.App().Flash().Infof("Service %s scaled to %d tasks", serviceName, count)
+		ecs.Start()
+		return nil
+	}, func() {})
+	return nil
+}
+
+func (ecs *EcsServices) forceDeployCmd(evt *tcell.EventKey) *tcell.EventKey {
+	serviceName := ecs.GetTable().GetSelectedItem()
+	if serviceName == "" {
+		return nil
+	}
+	cfg, clusterName := ecs.cluster()
+	msg := fmt.Sprintf("Force a new deployment of service %s?", serviceName)
+	dialog.ShowConfirm(ecs.App().Content.Pages, "force deploy", msg, func() {
+		if err := aws.ForceEcsServiceDeployment(cfg, clusterName, serviceName); err != nil {
+			ecs.App().Flash().Err(err)
+			return
+		}
+		ecs.App().Flash().Infof("New deployment of %s started", serviceName)
+		ecs.Start()
+	}, func() {})
+	return nil
+}
+
+func (ecs *EcsServices) deploymentsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	serviceName := ecs.GetTable().GetSelectedItem()
+	if serviceName == "" {
+		return nil
+	}
+	deploymentsScreen := NewEcsDeployments(serviceName)
+	ctx := ecs.App().GetContext()
+	clusterName := ctx.Value(internal.ECSClusterName).(string)
+	ctx = context.WithValue(ctx, internal.ECSServiceName, serviceName)
+	ecs.App().SetContext(ctx)
+	ecs.App().inject(deploymentsScreen)
+	deploymentsScreen.GetTable().SetTitle(fmt.Sprintf(" ecs://%s/%s/deployments ", clusterName, serviceName))
+	ecs.App().Flash().Infof("Viewing deployments of %s...", serviceName)
+	return nil
+}
+
+func (ecs *EcsServices) taskDefinitionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	arn := ecs.GetTable().GetSelectedColumn("TaskDefinition")
+	if arn == "" {
+		return nil
+	}
+	name := arn[strings.LastIndex(arn, "/")+1:]
+	family, _, _ := strings.Cut(name, ":")
+	showTaskDefinitions(ecs.App(), family)
+	describeResource(ecs.App(), nil, internal.LowercaseEcsTaskDefinitionRevisions, name)
+	return nil
+}
@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcsService(t *testing.T) {
+	ecs := NewEcsService("cluster")
+	assert.Nil(t, ecs.Init(makeCtx()))
+	assert.Equal(t, "cluster", ecs.Name())
+	assert.Equal(t, 10, len(ecs.Hints()))
+}
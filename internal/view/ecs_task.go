@@ -3,14 +3,24 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
 	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+const (
+	defaultStopTaskReason = "Stopped from cloudlens"
+	ecsTaskStopped        = "STOPPED"
 )
 
 type EcsTask struct {
-	name string
+	name    string
+	stopped bool
 	ResourceViewer
 }
 
@@ -23,6 +33,17 @@ func NewEcsTask(serviceName string) ResourceViewer {
 	return &ecs
 }
 
+// Init lists the running tasks, and the stopped ones once toggled.
+func (ecsTask *EcsTask) Init(ctx context.Context) error {
+	if err := ecsTask.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	ecsTask.SetContextFn(func(c context.Context) context.Context {
+		return context.WithValue(ctx, internal.ECSTaskStopped, ecsTask.stopped)
+	})
+	return nil
+}
+
 func (ecsTask *EcsTask) Name() string {
 	return ecsTask.name
 }
@@ -30,6 +51,8 @@ func (ecsTask *EcsTask) Name() string {
 func (ecsTask *EcsTask) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyD:         ui.NewKeyAction("Describe", ecsTask.describeEcsTask, true),
+		tcell.KeyCtrlK:  ui.NewKeyAction("Stop", ecsTask.stopCmd, true),
+		ui.KeyA:         ui.NewKeyAction("Toggle Stopped", ecsTask.toggleStoppedCmd, true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", ecsTask.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", ecsTask.enterCmd, true),
 	})
@@ -65,3 +88,66 @@ func (ecsTask *EcsTask) describeEcsTask(evt *tcell.EventKey) *tcell.EventKey {
 	ecsTask.App().Flash().Infof("Task %s", taskId)
 	return nil
 }
+
+func (ecsTask *EcsTask) toggleStoppedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ecsTask.stopped = !ecsTask.stopped
+	ecsTask.Start()
+	if ecsTask.stopped {
+		ecsTask.App().Flash().Info("Showing running and stopped tasks")
+		return nil
+	}
+	ecsTask.App().Flash().Info("Showing running tasks")
+	return nil
+}
+
+// stoppableTasks drops the already stopped tasks from the given ones.
+func (ecsTask *EcsTask) stoppableTasks(taskIds []string) []string {
+	data := ecsTask.GetTable().GetModel().Peek()
+	idx := data.Header.IndexOf("LastStatus", true)
+	stopped := make(map[string]struct{})
+	for _, re := range data.RowEvents {
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] == ecsTaskStopped {
+			stopped[re.Row.Fields[0]] = struct{}{}
+		}
+	}
+	ids := make([]string, 0, len(taskIds))
+	for _, id := range taskIds {
+		if _, ok := stopped[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (ecsTask *EcsTask) stopCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selected := ecsTask.GetTable().GetSelectedItems()
+	if len(selected) == 0 {
+		return nil
+	}
+	taskIds := ecsTask.stoppableTasks(selected)
+	if len(taskIds) == 0 {
+		ecsTask.App().Flash().Warn("Selected task(s) already stopped")
+		return nil
+	}
+	ctx := ecsTask.App().GetContext()
+	cfg, ok := ctx.Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		ecsTask.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	clusterName, _ := ctx.Value(internal.ECSClusterName).(string)
+	fields := []dialog.FormField{{Label: "Reason:", Value: defaultStopTaskReason}}
+	msg := fmt.Sprintf("Stop %d task(s) %s?", len(taskIds), strings.Join(taskIds, ", "))
+	dialog.ShowForm(ecsTask.App().Content.Pages, "stop", msg, fields, func(values []string) error {
+		n, err := aws.StopEcsTasks(cfg, clusterName, taskIds, strings.TrimSpace(values[0]))
+		ecsTask.GetTable().ClearMarks()
+		ecsTask.Start()
+		if err != nil {
+			ecsTask.App().Flash().Errf("Stopped %d of %d tasks: %v", n, len(taskIds), err)
+			return nil
+		}
+		ecsTask.App().Flash().Infof("Stopped %d task(s)", n)
+		return nil
+	}, func() {})
+	return nil
+}
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+type EcsTaskDefinitionFamilies struct {
+	ResourceViewer
+}
+
+func NewEcsTaskDefinitionFamilies(resource string) ResourceViewer {
+	var ecs EcsTaskDefinitionFamilies
+	ecs.ResourceViewer = NewBrowser(resource)
+	ecs.AddBindKeysFn(ecs.bindKeys)
+	return &ecs
+}
+
+func (ecs *EcsTaskDefinitionFamilies) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyD:         ui.NewKeyAction("Describe Latest", describeSelected(ecs, "Task definition"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", ecs.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", ecs.enterCmd, false),
+	})
+}
+
+func (ecs *EcsTaskDefinitionFamilies) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	family := ecs.GetTable().GetSelectedItem()
+	if family == "" {
+		return nil
+	}
+	showTaskDefinitions(ecs.App(), family)
+	return nil
+}
+
+// showTaskDefinitions drills into the revisions of a task definition family.
+func showTaskDefinitions(app *App, family string) {
+	revisionsScreen := NewEcsTaskDefinitions(family)
+	ctx := context.WithValue(app.GetContext(), internal.ECSTaskDefinitionFamily, family)
+	app.SetContext(ctx)
+	app.inject(revisionsScreen)
+	revisionsScreen.GetTable().SetTitle(fmt.Sprintf(" ecs://task-definitions/%s ", family))
+	app.Flash().Infof("Viewing %s revisions...", family)
+}
+
+type EcsTaskDefinitions struct {
+	name string
+	ResourceViewer
+}
+
+func NewEcsTaskDefinitions(family string) ResourceViewer {
+	var ecs EcsTaskDefinitions
+	ecs.name = family
+	ecs.ResourceViewer = NewBrowser(internal.LowercaseEcsTaskDefinitionRevisions)
+	ecs.AddBindKeysFn(ecs.bindKeys)
+	return &ecs
+}
+
+func (ecs *EcsTaskDefinitions) Name() string {
+	return ecs.name
+}
+
+func (ecs *EcsTaskDefinitions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(ecs, "Task definition"), true),
+		ui.KeyShiftD:    ui.NewKeyAction("Diff", ecs.diffCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Deregister", ecs.deregisterCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", ecs.App().PrevCmd, false),
+	})
+}
+
+func (ecs *EcsTaskDefinitions) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	revisions := ecs.GetTable().GetSelectedItems()
+	if len(revisions) != 2 {
+		ecs.App().Flash().Warn("Mark exactly two revisions to diff")
+		return nil
+	}
+	sortRevisions(revisions)
+	cfg, _ := ecs.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	diff, err := aws.DiffEcsTaskDefinitions(cfg, revisions[0], revisions[1])
+	if err != nil {
+		ecs.App().Flash().Err(err)
+		return nil
+	}
+	if diff == "" {
+		diff = fmt.Sprintf("%s and %s are identical", revisions[0], revisions[1])
+	}
+	v := NewLiveView(ecs.App(), "Diff", model.NewText(strings.Join(revisions, ".."), diff))
+	if err := ecs.App().inject(v); err != nil {
+		ecs.App().Flash().Err(err)
+	}
+	return nil
+}
+
+func (ecs *EcsTaskDefinitions) deregisterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	revisions := ecs.GetTable().GetSelectedItems()
+	if len(revisions) == 0 {
+		return nil
+	}
+	sortRevisions(revisions)
+	cfg, _ := ecs.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	msg := fmt.Sprintf("Deregister %d revision(s) %s?", len(revisions), strings.Join(revisions, ", "))
+	dialog.ShowConfirm(ecs.App().Content.Pages, "deregister", msg, func() {
+		n, err := aws.DeregisterEcsTaskDefinitions(cfg, revisions)
+		ecs.GetTable().ClearMarks()
+		ecs.Start()
+		if err != nil {
+			ecs.App().Flash().Errf("Deregistered %d of %d revisions: %v", n, len(revisions), err)
+			return
+		}
+		ecs.App().Flash().Infof("Deregistered %d revision(s)", n)
+	}, func() {})
+	return nil
+}
+
+// sortRevisions orders family:revision names oldest first.
+func sortRevisions(revisions []string) {
+	revision := func(name string) int {
+		_, rev, _ := strings.Cut(name, ":")
+		n, _ := strconv.Atoi(rev)
+		return n
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revision(revisions[i]) < revision(revisions[j])
+	})
+}
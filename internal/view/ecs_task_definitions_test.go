@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcsTaskDefinitions(t *testing.T) {
+	ecs := NewEcsTaskDefinitionFamilies("ecs:td")
+	assert.Nil(t, ecs.Init(makeCtx()))
+	assert.Equal(t, 6, len(ecs.Hints()))
+
+	revisions := NewEcsTaskDefinitions("web")
+	assert.Nil(t, revisions.Init(makeCtx()))
+	assert.Equal(t, "web", revisions.Name())
+	assert.Equal(t, 7, len(revisions.Hints()))
+}
+
+func TestSortRevisions(t *testing.T) {
+	revisions := []string{"web:10", "web:9"}
+	sortRevisions(revisions)
+
+	assert.Equal(t, []string{"web:9", "web:10"}, revisions)
+}
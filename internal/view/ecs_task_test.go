@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEcsTask(t *testing.T) {
+	ecs := NewEcsTask("service")
+	assert.Nil(t, ecs.Init(makeCtx()))
+	assert.Equal(t, "service", ecs.Name())
+	assert.Equal(t, 8, len(ecs.Hints()))
+}
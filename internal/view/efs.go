@@ -0,0 +1,142 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Efs struct {
+	ResourceViewer
+}
+
+func NewEfs(resource string) ResourceViewer {
+	var e Efs
+	e.ResourceViewer = NewBrowser(resource)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *Efs) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", e.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", e.GetTable().SortColCmd("State", true), true),
+		ui.KeyA:         ui.NewKeyAction("Access Points", e.drillCmd("access points", NewEfsAccessPoints), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "File System"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Mount Targets", e.drillCmd("mount targets", NewEfsMountTargets), false),
+	})
+}
+
+func (e *Efs) drillCmd(what string, viewerFn func(string) ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		id := e.GetTable().GetSelectedItem()
+		if id == "" {
+			return nil
+		}
+		v := viewerFn(id)
+		e.App().SetContext(context.WithValue(e.App().GetContext(), internal.EFSFileSystemId, id))
+		e.App().inject(v)
+		v.GetTable().SetTitle(fmt.Sprintf(" efs://%s/%s ", id, strings.ReplaceAll(what, " ", "-")))
+		e.App().Flash().Infof("Viewing %s %s...", id, what)
+		return nil
+	}
+}
+
+type EfsMountTargets struct {
+	id string
+	ResourceViewer
+}
+
+func NewEfsMountTargets(id string) ResourceViewer {
+	var e EfsMountTargets
+	e.id = id
+	e.ResourceViewer = NewBrowser(internal.LowercaseEfsMounts)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EfsMountTargets) Name() string {
+	return e.id
+}
+
+func (e *EfsMountTargets) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftZ:    ui.NewKeyAction("Sort Zone", e.GetTable().SortColCmd("Zone", true), true),
+		ui.KeyS:         ui.NewKeyAction("Subnet", e.subnetCmd, true),
+		ui.KeyG:         ui.NewKeyAction("Security Groups", e.securityGroupsCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Mount Target"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(e, "Mount Target"), false),
+	})
+}
+
+// subnetCmd opens the subnets view of the mount target VPC filtered on its
+// subnet.
+func (e *EfsMountTargets) subnetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.GetTable().GetSelectedItem() == "" {
+		return nil
+	}
+	subnet, vpcId := e.GetTable().GetSelectedCell(1), e.GetTable().GetSelectedCell(5)
+	v := NewSubnet(internal.LowercaseSubnet)
+	e.App().SetContext(context.WithValue(e.App().GetContext(), internal.VpcId, vpcId))
+	if err := e.App().inject(v); err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	v.GetTable().Filter(regexp.QuoteMeta(subnet))
+	e.App().Flash().Infof("Viewing subnet %s...", subnet)
+	return nil
+}
+
+func (e *EfsMountTargets) securityGroupsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	target := e.GetTable().GetSelectedItem()
+	if target == "" {
+		return nil
+	}
+	groups := e.GetTable().GetSelectedCell(6)
+	if groups == "" {
+		e.App().Flash().Warnf("Mount target %s has no security groups", target)
+		return nil
+	}
+	v := NewSG(internal.LowercaseSg)
+	if err := e.App().inject(v); err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	v.GetTable().Filter(sgFilter(strings.Split(groups, ",")))
+	e.App().Flash().Infof("Viewing security groups of %s...", target)
+	return nil
+}
+
+type EfsAccessPoints struct {
+	id string
+	ResourceViewer
+}
+
+func NewEfsAccessPoints(id string) ResourceViewer {
+	var e EfsAccessPoints
+	e.id = id
+	e.ResourceViewer = NewBrowser(internal.LowercaseEfsAccess)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EfsAccessPoints) Name() string {
+	return e.id
+}
+
+func (e *EfsAccessPoints) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", e.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftP:    ui.NewKeyAction("Sort Path", e.GetTable().SortColCmd("Path", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Access Point"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(e, "Access Point"), false),
+	})
+}
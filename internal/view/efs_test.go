@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEfs(t *testing.T) {
+	efs := NewEfs("efs")
+	assert.Nil(t, efs.Init(makeCtx()))
+	assert.Equal(t, "efs", efs.Name())
+	assert.Equal(t, 9, len(efs.Hints()))
+}
+
+func TestNewEfsMountTargets(t *testing.T) {
+	targets := NewEfsMountTargets("fs-0123456789abcdef0")
+	assert.Nil(t, targets.Init(makeCtx()))
+	assert.Equal(t, "fs-0123456789abcdef0", targets.Name())
+	assert.Equal(t, 9, len(targets.Hints()))
+}
+
+func TestNewEfsAccessPoints(t *testing.T) {
+	accessPoints := NewEfsAccessPoints("fs-0123456789abcdef0")
+	assert.Nil(t, accessPoints.Init(makeCtx()))
+	assert.Equal(t, "fs-0123456789abcdef0", accessPoints.Name())
+	assert.Equal(t, 8, len(accessPoints.Hints()))
+}
@@ -0,0 +1,127 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+const k9sCmd = "k9s"
+
+type Eks struct {
+	ResourceViewer
+}
+
+func NewEks(resource string) ResourceViewer {
+	var e Eks
+	e.ResourceViewer = NewBrowser(resource)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *Eks) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftV:    ui.NewKeyAction("Sort Version", e.GetTable().SortColCmd("Version", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Cluster"), true),
+		ui.KeyK:         ui.NewKeyAction("Kubeconfig", e.kubeconfigCmd, true),
+		ui.KeyO:         ui.NewKeyAction("Open in k9s", e.k9sCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", e.enterCmd, false),
+	})
+}
+
+func (e *Eks) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	clusterName := e.GetTable().GetSelectedItem()
+	if clusterName == "" {
+		return nil
+	}
+	nodeGroupsScreen := NewEksNodeGroups(clusterName)
+	ctx := context.WithValue(e.App().GetContext(), internal.EKSClusterName, clusterName)
+	e.App().SetContext(ctx)
+	e.App().inject(nodeGroupsScreen)
+	nodeGroupsScreen.GetTable().SetTitle(fmt.Sprintf(" eks://%s ", clusterName))
+	e.App().Flash().Infof("Viewing %s node groups...", clusterName)
+	return nil
+}
+
+// writeKubeconfig merges a context for the selected cluster into the kubeconfig.
+func (e *Eks) writeKubeconfig() (string, error) {
+	clusterName := e.GetTable().GetSelectedItem()
+	if clusterName == "" {
+		return "", errors.New("no cluster selected")
+	}
+	cfg, ok := e.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+	}
+	c, err := aws.GetEksClusterResp(cfg, clusterName)
+	if err != nil {
+		return "", err
+	}
+	path, err := aws.KubeConfigPath()
+	if err != nil {
+		return "", err
+	}
+	kubeContext, err := aws.WriteEksKubeConfig(path, *c, cfg.Region, activeProfile(e.App()))
+	if err != nil {
+		return "", err
+	}
+	e.App().Flash().Infof("Context %s written to %s", kubeContext, path)
+	return kubeContext, nil
+}
+
+func (e *Eks) kubeconfigCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if _, err := e.writeKubeconfig(); err != nil {
+		e.App().Flash().Err(err)
+	}
+	return nil
+}
+
+func (e *Eks) k9sCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if _, err := exec.LookPath(k9sCmd); err != nil {
+		e.App().Flash().Errf("%s not found in PATH, install it from https://k9scli.io to open clusters in it", k9sCmd)
+		return nil
+	}
+	kubeContext, err := e.writeKubeconfig()
+	if err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	if err := runInteractive(e.App(), k9sCmd, "--context", kubeContext); err != nil {
+		e.App().Flash().Errf("%s exited: %v", k9sCmd, err)
+	}
+	return nil
+}
+
+type EksNodeGroups struct {
+	name string
+	ResourceViewer
+}
+
+func NewEksNodeGroups(clusterName string) ResourceViewer {
+	var e EksNodeGroups
+	e.name = clusterName
+	e.ResourceViewer = NewBrowser(internal.LowercaseEksNodeGroups)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EksNodeGroups) Name() string {
+	return e.name
+}
+
+func (e *EksNodeGroups) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("Type", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Node group"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(e, "Node group"), false),
+	})
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEks(t *testing.T) {
+	eks := NewEks("eks")
+	assert.Nil(t, eks.Init(makeCtx()))
+	assert.Equal(t, "eks", eks.Name())
+	assert.Equal(t, 9, len(eks.Hints()))
+}
+
+func TestNewEksNodeGroups(t *testing.T) {
+	ng := NewEksNodeGroups("prod")
+	assert.Nil(t, ng.Init(makeCtx()))
+	assert.Equal(t, "prod", ng.Name())
+	assert.Equal(t, 7, len(ng.Hints()))
+}
@@ -0,0 +1,138 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Cache struct {
+	ResourceViewer
+}
+
+func NewCache(resource string) ResourceViewer {
+	var c Cache
+	c.ResourceViewer = NewBrowser(resource)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *Cache) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftI:    ui.NewKeyAction("Sort Id", c.GetTable().SortColCmd("Id", true), true),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", c.GetTable().SortColCmd("Kind", true), true),
+		ui.KeyShiftE:    ui.NewKeyAction("Sort Engine", c.GetTable().SortColCmd("Engine", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", c.describeCmd, true),
+		ui.KeyG:         ui.NewKeyAction("Security Groups", c.securityGroupsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Nodes", c.enterCmd, false),
+	})
+}
+
+func (c *Cache) selectedCache() (string, string) {
+	id := c.GetTable().GetSelectedItem()
+	if id == "" {
+		return "", ""
+	}
+	return c.GetTable().GetSelectedCell(1), id
+}
+
+func (c *Cache) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	kind, id := c.selectedCache()
+	if id == "" {
+		return nil
+	}
+	nodesScreen := NewCacheNodes(id)
+	ctx := context.WithValue(c.App().GetContext(), internal.CacheKind, kind)
+	c.App().SetContext(context.WithValue(ctx, internal.CacheId, id))
+	c.App().inject(nodesScreen)
+	nodesScreen.GetTable().SetTitle(fmt.Sprintf(" cache://%s ", id))
+	c.App().Flash().Infof("Viewing %s nodes...", id)
+	return nil
+}
+
+func (c *Cache) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	kind, id := c.selectedCache()
+	if id == "" {
+		return nil
+	}
+	describeResource(c.App(), c.GetTable().GetModel(), c.Resource(), kind+"/"+id)
+	c.App().Flash().Infof("Cache %s", id)
+	return nil
+}
+
+// securityGroupsCmd opens the security groups view filtered on the groups of
+// the selected cache.
+func (c *Cache) securityGroupsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	kind, id := c.selectedCache()
+	if id == "" {
+		return nil
+	}
+	cfg, ok := c.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		c.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	details, err := aws.GetCache(cfg, kind, id)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if len(details.SecurityGroups) == 0 {
+		c.App().Flash().Warnf("Cache %s has no VPC security groups", id)
+		return nil
+	}
+	v := NewSG(internal.LowercaseSg)
+	if err := c.App().inject(v); err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	v.GetTable().Filter(sgFilter(details.SecurityGroups))
+	c.App().Flash().Infof("Viewing security groups of %s...", id)
+	return nil
+}
+
+// sgFilter returns a table filter matching any of the given groups.
+func sgFilter(groups []string) string {
+	quoted := make([]string, len(groups))
+	for i, g := range groups {
+		quoted[i] = regexp.QuoteMeta(g)
+	}
+	return strings.Join(quoted, "|")
+}
+
+type CacheNodes struct {
+	id string
+	ResourceViewer
+}
+
+func NewCacheNodes(id string) ResourceViewer {
+	var c CacheNodes
+	c.id = id
+	c.ResourceViewer = NewBrowser(internal.LowercaseCacheNodes)
+	c.AddBindKeysFn(c.bindKeys)
+	return &c
+}
+
+func (c *CacheNodes) Name() string {
+	return c.id
+}
+
+func (c *CacheNodes) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR:    ui.NewKeyAction("Sort Role", c.GetTable().SortColCmd("Role", true), true),
+		ui.KeyShiftZ:    ui.NewKeyAction("Sort Zone", c.GetTable().SortColCmd("Zone", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(c, "Node"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(c, "Node"), false),
+	})
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCache(t *testing.T) {
+	cache := NewCache("cache")
+	assert.Nil(t, cache.Init(makeCtx()))
+	assert.Equal(t, "cache", cache.Name())
+	assert.Equal(t, 11, len(cache.Hints()))
+}
+
+func TestNewCacheNodes(t *testing.T) {
+	nodes := NewCacheNodes("sessions")
+	assert.Nil(t, nodes.Init(makeCtx()))
+	assert.Equal(t, "sessions", nodes.Name())
+	assert.Equal(t, 9, len(nodes.Hints()))
+}
+
+func TestSgFilter(t *testing.T) {
+	assert.Equal(t, "sg-1|sg-2", sgFilter([]string{"sg-1", "sg-2"}))
+}
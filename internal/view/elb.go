@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Elb struct {
+	ResourceViewer
+}
+
+func NewElb(resource string) ResourceViewer {
+	var e Elb
+	e.ResourceViewer = NewBrowser(resource)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *Elb) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("Type", true), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", e.GetTable().SortColCmd("Created", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", e.describeCmd, true),
+		ui.KeyT:         ui.NewKeyAction("Targets", e.targetsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", e.enterCmd, false),
+	})
+}
+
+func (e *Elb) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := e.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	describeResource(e.App(), e.GetTable().GetModel(), e.Resource(), name+"/"+e.GetTable().GetSelectedCell(1))
+	e.App().Flash().Infof("Load Balancer %s", name)
+	return nil
+}
+
+func (e *Elb) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := e.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	rulesScreen := NewElbRules(name)
+	ctx := context.WithValue(e.App().GetContext(), internal.ELBName, name)
+	ctx = context.WithValue(ctx, internal.ELBType, e.GetTable().GetSelectedCell(1))
+	e.App().SetContext(ctx)
+	e.App().inject(rulesScreen)
+	rulesScreen.GetTable().SetTitle(fmt.Sprintf(" elb://%s ", name))
+	e.App().Flash().Infof("Viewing %s listeners...", name)
+	return nil
+}
+
+// targetsCmd shows the targets of a classic load balancer. Other load
+// balancers reach their targets through their listener rules.
+func (e *Elb) targetsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := e.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	lbType := e.GetTable().GetSelectedCell(1)
+	if lbType != aws.ElbClassicType {
+		e.App().Flash().Warnf("Select a listener rule of %s to view its targets", name)
+		return nil
+	}
+	ctx := context.WithValue(e.App().GetContext(), internal.ELBName, name)
+	ctx = context.WithValue(ctx, internal.ELBType, lbType)
+	showElbTargets(e.App(), ctx, name, "")
+	return nil
+}
+
+type ElbRules struct {
+	name string
+	ResourceViewer
+}
+
+func NewElbRules(lb string) ResourceViewer {
+	var e ElbRules
+	e.name = lb
+	e.ResourceViewer = NewBrowser(internal.LowercaseElbRules)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *ElbRules) Name() string {
+	return e.name
+}
+
+func (e *ElbRules) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftL:    ui.NewKeyAction("Sort Listener", e.GetTable().SortColCmd("Listener", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Rule"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Targets", e.enterCmd, false),
+	})
+}
+
+func (e *ElbRules) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rule := e.GetTable().GetSelectedItem()
+	if rule == "" {
+		return nil
+	}
+	lbType, _ := e.App().GetContext().Value(internal.ELBType).(string)
+	tgs := e.GetTable().GetSelectedCell(5)
+	if tgs == "" && lbType != aws.ElbClassicType {
+		e.App().Flash().Warnf("Rule %s does not forward to a target group", rule)
+		return nil
+	}
+	ctx := context.WithValue(e.App().GetContext(), internal.ELBTargetGroups, tgs)
+	showElbTargets(e.App(), ctx, e.name, tgs)
+	return nil
+}
+
+func showElbTargets(app *App, ctx context.Context, lb, targetGroups string) {
+	targetsScreen := NewElbTargets(lb)
+	app.SetContext(ctx)
+	app.inject(targetsScreen)
+	title := fmt.Sprintf(" elb://%s/targets ", lb)
+	if targetGroups != "" {
+		title = fmt.Sprintf(" elb://%s/%s ", lb, targetGroups)
+	}
+	targetsScreen.GetTable().SetTitle(title)
+	app.Flash().Infof("Viewing %s targets...", lb)
+}
+
+type ElbTargets struct {
+	name string
+	ResourceViewer
+}
+
+func NewElbTargets(lb string) ResourceViewer {
+	var e ElbTargets
+	e.name = lb
+	e.ResourceViewer = NewBrowser(internal.LowercaseElbTargets)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *ElbTargets) Name() string {
+	return e.name
+}
+
+func (e *ElbTargets) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", e.GetTable().SortColCmd("State", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Target"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(e, "Target"), false),
+	})
+}
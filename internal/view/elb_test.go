@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewElb(t *testing.T) {
+	elb := NewElb("elb")
+	assert.Nil(t, elb.Init(makeCtx()))
+	assert.Equal(t, "elb", elb.Name())
+	assert.Equal(t, 9, len(elb.Hints()))
+}
+
+func TestNewElbRules(t *testing.T) {
+	rules := NewElbRules("web")
+	assert.Nil(t, rules.Init(makeCtx()))
+	assert.Equal(t, "web", rules.Name())
+	assert.Equal(t, 7, len(rules.Hints()))
+}
+
+func TestNewElbTargets(t *testing.T) {
+	targets := NewElbTargets("web")
+	assert.Nil(t, targets.Init(makeCtx()))
+	assert.Equal(t, "web", targets.Name())
+	assert.Equal(t, 7, len(targets.Hints()))
+}
@@ -0,0 +1,216 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+type Events struct {
+	ResourceViewer
+}
+
+func NewEvents(resource string) ResourceViewer {
+	var e Events
+	e.ResourceViewer = NewBrowser(resource)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *Events) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", e.GetTable().SortColCmd("Name", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Event bus"), true),
+		ui.KeyP:         ui.NewKeyAction("Put Event", e.putEventCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Rules", e.enterCmd, false),
+	})
+}
+
+func (e *Events) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	bus := e.GetTable().GetSelectedItem()
+	if bus == "" {
+		return nil
+	}
+	rulesScreen := NewEventRules(bus)
+	e.App().SetContext(context.WithValue(e.App().GetContext(), internal.EventBusName, bus))
+	e.App().inject(rulesScreen)
+	rulesScreen.GetTable().SetTitle(fmt.Sprintf(" events://%s ", bus))
+	e.App().Flash().Infof("Viewing %s rules...", bus)
+	return nil
+}
+
+func (e *Events) putEventCmd(evt *tcell.EventKey) *tcell.EventKey {
+	bus := e.GetTable().GetSelectedItem()
+	if bus == "" {
+		return nil
+	}
+	showPutEvent(e.App(), bus)
+	return nil
+}
+
+// showPutEvent asks for a test event and puts it on a bus.
+func showPutEvent(app *App, bus string) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	fields := []dialog.FormField{
+		{Label: "Source:", Value: "cloudlens.test"},
+		{Label: "Detail-Type:", Value: "cloudlens test event"},
+		{Label: "Detail (JSON):", Value: "{}"},
+	}
+	dialog.ShowForm(app.Content.Pages, "put event", bus, fields, func(values []string) error {
+		id, err := aws.PutEvent(cfg, bus, values[0], values[1], values[2])
+		if err != nil {
+			return err
+		}
+		app.Flash().Infof("Event %s put on %s", id, bus)
+		return nil
+	}, func() {})
+}
+
+type EventRules struct {
+	bus string
+	ResourceViewer
+}
+
+func NewEventRules(bus string) ResourceViewer {
+	var e EventRules
+	e.bus = bus
+	e.ResourceViewer = NewBrowser(internal.LowercaseEventRules)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EventRules) Name() string {
+	return e.bus
+}
+
+func (e *EventRules) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", e.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", e.GetTable().SortColCmd("State", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Rule"), true),
+		ui.KeyE:         ui.NewKeyAction("Enable/Disable", e.toggleCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Put Event", e.putEventCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Targets", e.enterCmd, false),
+	})
+}
+
+func (e *EventRules) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rule := e.GetTable().GetSelectedItem()
+	if rule == "" {
+		return nil
+	}
+	targetsScreen := NewEventTargets(rule)
+	e.App().SetContext(context.WithValue(e.App().GetContext(), internal.EventRuleName, rule))
+	e.App().inject(targetsScreen)
+	targetsScreen.GetTable().SetTitle(fmt.Sprintf(" events://%s/%s ", e.bus, rule))
+	e.App().Flash().Infof("Viewing %s targets...", rule)
+	return nil
+}
+
+func (e *EventRules) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rule := e.GetTable().GetSelectedItem()
+	if rule == "" {
+		return nil
+	}
+	cfg, ok := e.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		e.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	state := e.GetTable().GetSelectedColumn("State")
+	action, toggle := "Enable", aws.EnableEventRule
+	// Rules may also be ENABLED_WITH_ALL_CLOUDTRAIL_MANAGEMENT_EVENTS.
+	if strings.HasPrefix(state, "ENABLED") {
+		action, toggle = "Disable", aws.DisableEventRule
+	}
+	msg := fmt.Sprintf("%s rule %s?", action, rule)
+	if state != "ENABLED" && action == "Disable" {
+		msg = fmt.Sprintf("Disable rule %s? Enabling it again will not match all CloudTrail management events.", rule)
+	}
+	dialog.ShowConfirm(e.App().Content.Pages, "toggle rule", msg, func() {
+		if err := toggle(cfg, e.bus, rule); err != nil {
+			e.App().Flash().Err(err)
+			return
+		}
+		e.App().Flash().Infof("%sd rule %s", action, rule)
+		e.Start()
+	}, func() {})
+	return nil
+}
+
+func (e *EventRules) putEventCmd(evt *tcell.EventKey) *tcell.EventKey {
+	showPutEvent(e.App(), e.bus)
+	return nil
+}
+
+type EventTargets struct {
+	rule string
+	ResourceViewer
+}
+
+func NewEventTargets(rule string) ResourceViewer {
+	var e EventTargets
+	e.rule = rule
+	e.ResourceViewer = NewBrowser(internal.LowercaseEventTargets)
+	e.AddBindKeysFn(e.bindKeys)
+	return &e
+}
+
+func (e *EventTargets) Name() string {
+	return e.rule
+}
+
+func (e *EventTargets) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(e, "Target"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(e, "Target"), false),
+	})
+}
+
+type Schedules struct {
+	ResourceViewer
+}
+
+func NewSchedules(resource string) ResourceViewer {
+	var s Schedules
+	s.ResourceViewer = NewBrowser(resource)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *Schedules) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftG:    ui.NewKeyAction("Sort Group", s.GetTable().SortColCmd("Group", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", s.GetTable().SortColCmd("State", true), true),
+		ui.KeyShiftL:    ui.NewKeyAction("Sort Last-Modified", s.GetTable().SortColCmd("Last-Modified", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", s.describeCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", s.describeCmd, false),
+	})
+}
+
+func (s *Schedules) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	path := s.GetTable().GetSelectedCell(1) + "/" + name
+	describeResource(s.App(), s.GetTable().GetModel(), s.Resource(), path)
+	s.App().Flash().Infof("Schedule %s", path)
+	return nil
+}
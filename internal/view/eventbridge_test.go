@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEvents(t *testing.T) {
+	events := NewEvents("events")
+	assert.Nil(t, events.Init(makeCtx()))
+	assert.Equal(t, "events", events.Name())
+	assert.Equal(t, 8, len(events.Hints()))
+}
+
+func TestNewEventRules(t *testing.T) {
+	rules := NewEventRules("default")
+	assert.Nil(t, rules.Init(makeCtx()))
+	assert.Equal(t, "default", rules.Name())
+	assert.Equal(t, 10, len(rules.Hints()))
+}
+
+func TestNewEventTargets(t *testing.T) {
+	targets := NewEventTargets("nightly")
+	assert.Nil(t, targets.Init(makeCtx()))
+	assert.Equal(t, "nightly", targets.Name())
+	assert.Equal(t, 6, len(targets.Hints()))
+}
+
+func TestNewSchedules(t *testing.T) {
+	schedules := NewSchedules("schedules")
+	assert.Nil(t, schedules.Init(makeCtx()))
+	assert.Equal(t, "schedules", schedules.Name())
+	assert.Equal(t, 10, len(schedules.Hints()))
+}
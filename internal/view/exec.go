@@ -0,0 +1,35 @@
+package view
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const defaultEditor = "vi"
+
+// runInteractive suspends the app and runs a command attached to the terminal.
+func runInteractive(app *App, name string, args ...string) error {
+	var err error
+	ok := app.Suspend(func() {
+		cmd := exec.Command(name, args...)
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		err = cmd.Run()
+	})
+	if !ok {
+		return errors.New("unable to suspend the application")
+	}
+
+	return err
+}
+
+// editFile opens a file in the user $EDITOR.
+func editFile(app *App, path string) error {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+
+	return runInteractive(app, editor[0], append(editor[1:], path)...)
+}
@@ -202,6 +202,14 @@ func (h *Help) showGeneral() model.MenuHints {
 			Mnemonic:    "z",
 			Description: "Save csv",
 		},
+		{
+			Mnemonic:    "space",
+			Description: "Mark",
+		},
+		{
+			Mnemonic:    "Ctrl-space",
+			Description: "Clear Marks",
+		},
 		{
 			Mnemonic:    "g",
 			Description: "Goto Top",
@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/atotto/clipboard"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+
 	"github.com/one2nc/cloudlens/internal"
 	"github.com/one2nc/cloudlens/internal/model"
 	"github.com/one2nc/cloudlens/internal/ui"
@@ -24,3 +28,48 @@ func describeResource(app *App, m ui.Tabular, resource, path string) {
 		app.Flash().Err(err)
 	}
 }
+
+// describeSelected describes the selected row of a resource viewer.
+func describeSelected(v ResourceViewer, kind string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return nil
+		}
+		f := describeResource
+		if v.GetTable().enterFn != nil {
+			f = v.GetTable().enterFn
+		}
+		f(v.App(), v.GetTable().GetModel(), v.Resource(), path)
+		v.App().Flash().Infof("%s %s", kind, path)
+		return nil
+	}
+}
+
+// revealValue shows a secret value fetched on demand, escaped so it is not
+// taken for color or region tags. The value is never flashed nor logged.
+func revealValue(app *App, path string, fetch func() (string, error)) {
+	value, err := fetch()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	v := NewLiveView(app, "Value", model.NewText(path, tview.Escape(value)))
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// copyValue copies a secret value fetched on demand to the clipboard.
+func copyValue(app *App, path string, fetch func() (string, error)) {
+	value, err := fetch()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := clipboard.WriteAll(value); err != nil {
+		app.Flash().Errf("Unable to copy the value of %s to the clipboard: %v", path, err)
+		return
+	}
+	app.Flash().Infof("Value of %s copied to the clipboard", path)
+}
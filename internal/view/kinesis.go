@@ -0,0 +1,144 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+type Kinesis struct {
+	ResourceViewer
+}
+
+func NewKinesis(resource string) ResourceViewer {
+	var k Kinesis
+	k.ResourceViewer = NewBrowser(resource)
+	k.AddBindKeysFn(k.bindKeys)
+	return &k
+}
+
+func (k *Kinesis) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", k.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", k.GetTable().SortColCmd("Status", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(k, "Stream"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", k.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Shards", k.enterCmd, false),
+	})
+}
+
+func (k *Kinesis) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	stream := k.GetTable().GetSelectedItem()
+	if stream == "" {
+		return nil
+	}
+	shardsScreen := NewKinesisShards(stream)
+	k.App().SetContext(context.WithValue(k.App().GetContext(), internal.KinesisStreamName, stream))
+	k.App().inject(shardsScreen)
+	shardsScreen.GetTable().SetTitle(fmt.Sprintf(" kinesis://%s ", stream))
+	k.App().Flash().Infof("Viewing %s shards...", stream)
+	return nil
+}
+
+type KinesisShards struct {
+	stream string
+	ResourceViewer
+}
+
+func NewKinesisShards(stream string) ResourceViewer {
+	var k KinesisShards
+	k.stream = stream
+	k.ResourceViewer = NewBrowser(internal.LowercaseKinesisShards)
+	k.AddBindKeysFn(k.bindKeys)
+	return &k
+}
+
+func (k *KinesisShards) Name() string {
+	return k.stream
+}
+
+func (k *KinesisShards) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", k.GetTable().SortColCmd("State", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(k, "Shard"), true),
+		ui.KeyP:         ui.NewKeyAction("Peek", k.peekCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", k.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Peek", k.peekCmd, false),
+	})
+}
+
+func (k *KinesisShards) peekCmd(evt *tcell.EventKey) *tcell.EventKey {
+	shard := k.GetTable().GetSelectedItem()
+	if shard == "" {
+		return nil
+	}
+	showShardPeek(k.App(), k.stream, shard)
+	return nil
+}
+
+// showShardPeek asks where to start reading a shard from and shows its
+// records, followed while auto-refresh is on.
+func showShardPeek(app *App, stream, shard string) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	fields := []dialog.FormField{
+		{Label: "Position:", Value: aws.ShardPositions[0], Options: aws.ShardPositions},
+		{Label: "Timestamp (RFC3339 or 15m):", Value: "15m"},
+	}
+	dialog.ShowForm(app.Content.Pages, "peek", shard, fields, func(values []string) error {
+		reader, err := aws.NewShardReader(cfg, stream, shard, values[0], values[1])
+		if err != nil {
+			return err
+		}
+		v := NewLiveView(app, "Records", model.NewTail(stream+"/"+shard, escapedRead(reader.Read)))
+		if err := app.inject(v); err != nil {
+			return err
+		}
+		app.Flash().Infof("Peeking %s from %s, toggle auto-refresh to follow", shard, values[0])
+		return nil
+	}, func() {})
+}
+
+// escapedRead escapes the lines read so record payloads are not taken for
+// color or region tags.
+func escapedRead(read model.TailReadFn) model.TailReadFn {
+	return func(ctx context.Context) ([]string, error) {
+		lines, err := read(ctx)
+		for i := range lines {
+			lines[i] = tview.Escape(lines[i])
+		}
+		return lines, err
+	}
+}
+
+type Firehose struct {
+	ResourceViewer
+}
+
+func NewFirehose(resource string) ResourceViewer {
+	var f Firehose
+	f.ResourceViewer = NewBrowser(resource)
+	f.AddBindKeysFn(f.bindKeys)
+	return &f
+}
+
+func (f *Firehose) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", f.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftD:    ui.NewKeyAction("Sort Destination", f.GetTable().SortColCmd("Destination", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(f, "Delivery stream"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(f, "Delivery stream"), false),
+	})
+}
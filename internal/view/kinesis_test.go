@@ -0,0 +1,40 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKinesis(t *testing.T) {
+	kinesis := NewKinesis("kinesis")
+	assert.Nil(t, kinesis.Init(makeCtx()))
+	assert.Equal(t, "kinesis", kinesis.Name())
+	assert.Equal(t, 8, len(kinesis.Hints()))
+}
+
+func TestNewKinesisShards(t *testing.T) {
+	shards := NewKinesisShards("clicks")
+	assert.Nil(t, shards.Init(makeCtx()))
+	assert.Equal(t, "clicks", shards.Name())
+	assert.Equal(t, 8, len(shards.Hints()))
+}
+
+func TestNewFirehose(t *testing.T) {
+	firehose := NewFirehose("firehose")
+	assert.Nil(t, firehose.Init(makeCtx()))
+	assert.Equal(t, "firehose", firehose.Name())
+	assert.Equal(t, 8, len(firehose.Hints()))
+}
+
+func TestEscapedRead(t *testing.T) {
+	read := escapedRead(func(ctx context.Context) ([]string, error) {
+		return []string{`{"tags": ["red"]}`, "[yellow::b]alert"}, nil
+	})
+
+	lines, err := read(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`{"tags": ["red"[]}`, "[yellow::b[]alert"}, lines)
+}
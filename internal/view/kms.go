@@ -0,0 +1,29 @@
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Kms struct {
+	ResourceViewer
+}
+
+func NewKms(resource string) ResourceViewer {
+	var k Kms
+	k.ResourceViewer = NewBrowser(resource)
+	k.AddBindKeysFn(k.bindKeys)
+	return &k
+}
+
+func (k *Kms) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftA:    ui.NewKeyAction("Sort Alias", k.GetTable().SortColCmd("Alias", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", k.GetTable().SortColCmd("State", true), true),
+		ui.KeyShiftR:    ui.NewKeyAction("Sort Rotation", k.GetTable().SortColCmd("Rotation", true), true),
+		ui.KeyShiftM:    ui.NewKeyAction("Sort Manager", k.GetTable().SortColCmd("Manager", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(k, "Key"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", k.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(k, "Key"), false),
+	})
+}
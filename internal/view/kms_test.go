@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKms(t *testing.T) {
+	kms := NewKms("kms")
+	assert.Nil(t, kms.Init(makeCtx()))
+	assert.Equal(t, "kms", kms.Name())
+	assert.Equal(t, 10, len(kms.Hints()))
+}
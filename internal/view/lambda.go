@@ -1,8 +1,27 @@
 package view
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/one2nc/cloudlens/internal/model"
 	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+const (
+	newLambdaEvent   = "<new>"
+	invokeSync       = "RequestResponse"
+	invokeAsync      = "Event"
+	emptyLambdaEvent = "{}\n"
 )
 
 type Lambda struct {
@@ -23,6 +42,231 @@ func (l *Lambda) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftA:    ui.NewKeyAction("Sort Function-Arn", l.GetTable().SortColCmd("Function-Arn", true), true),
 		ui.KeyShiftS:    ui.NewKeyAction("Sort Code-Size", l.GetTable().SortColCmd("Code-Size", true), true),
 		ui.KeyShiftM:    ui.NewKeyAction("Sort Last-Modified", l.GetTable().SortColCmd("Last-Modified", true), true),
+		ui.KeyI:         ui.NewKeyAction("Invoke", l.invokeCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(l, "Function"), true),
+		ui.KeyU:         ui.NewKeyAction("Reveal Env", l.revealEnvCmd, true),
+		ui.KeyV:         ui.NewKeyAction("Versions", l.detailsCmd("versions", NewLambdaVersions), true),
+		ui.KeyA:         ui.NewKeyAction("Aliases", l.detailsCmd("aliases", NewLambdaAliases), true),
+		ui.KeyE:         ui.NewKeyAction("Event Sources", l.detailsCmd("event-sources", NewLambdaEventSources), true),
+		ui.KeyL:         ui.NewKeyAction("Layers", l.detailsCmd("layers", NewLambdaLayers), true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", l.App().PrevCmd, false),
 	})
 }
+
+func (l *Lambda) detailsCmd(kind string, newFn func(string) ResourceViewer) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		functionName := l.GetTable().GetSelectedItem()
+		if functionName == "" {
+			return nil
+		}
+		showLambdaDetails(l.App(), functionName, kind, newFn(functionName))
+		return nil
+	}
+}
+
+func (l *Lambda) revealEnvCmd(evt *tcell.EventKey) *tcell.EventKey {
+	functionName := l.GetTable().GetSelectedItem()
+	if functionName == "" {
+		return nil
+	}
+	cfg, ok := l.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		l.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	fn, err := aws.GetLambdaFunction(cfg, functionName, "")
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	vars := map[string]string{}
+	if fn.Configuration.Environment != nil {
+		vars = fn.Configuration.Environment.Variables
+	}
+	b, err := json.MarshalIndent(vars, "", " ")
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	v := NewLiveView(l.App(), "Environment", model.NewText(functionName, tview.Escape(string(b))))
+	if err := l.App().inject(v); err != nil {
+		l.App().Flash().Err(err)
+	}
+	return nil
+}
+
+func (l *Lambda) invokeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	functionName := l.GetTable().GetSelectedItem()
+	if functionName == "" {
+		return nil
+	}
+	events, err := config.ListLambdaEvents(functionName)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	var saveAs string
+	if len(events) == 0 {
+		saveAs = config.DefaultLambdaEvent
+	}
+	fields := []dialog.FormField{
+		{Label: "Event:", Options: append(events, newLambdaEvent)},
+		{Label: "Save As:", Value: saveAs},
+		{Label: "Type:", Options: []string{invokeSync, invokeAsync}},
+	}
+	msg := fmt.Sprintf("Invoke %s, the payload opens in $EDITOR", functionName)
+	dialog.ShowForm(l.App().Content.Pages, "invoke", msg, fields, func(values []string) error {
+		event, name := values[0], strings.TrimSpace(values[1])
+		if name == "" {
+			if event == newLambdaEvent {
+				return fmt.Errorf("a name is required to save a new event")
+			}
+			name = event
+		}
+		if name == newLambdaEvent {
+			return fmt.Errorf("%s is not a valid event name", name)
+		}
+		async := values[2] == invokeAsync
+		if name == event || !lambdaEventExists(functionName, name) {
+			return l.prepareAndInvoke(functionName, event, name, async)
+		}
+		msg := fmt.Sprintf("Event %s already exists, overwrite it?", name)
+		dialog.ShowConfirm(l.App().Content.Pages, "Overwrite", msg, func() {
+			if err := l.prepareAndInvoke(functionName, event, name, async); err != nil {
+				l.App().Flash().Err(err)
+			}
+		}, func() {})
+		return nil
+	}, func() {})
+
+	return nil
+}
+
+// prepareAndInvoke seeds the named test event and invokes the function with it.
+func (l *Lambda) prepareAndInvoke(functionName, from, name string, async bool) error {
+	path, err := prepareLambdaEvent(functionName, from, name)
+	if err != nil {
+		return err
+	}
+	// Let the dialog close before handing the terminal over to the editor.
+	l.App().QueueUpdateDraw(func() {
+		l.invoke(functionName, path, async)
+	})
+	return nil
+}
+
+// lambdaEventExists checks whether the named test event is already saved.
+func lambdaEventExists(functionName, name string) bool {
+	path, err := config.LambdaEventPath(functionName, name)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// prepareLambdaEvent seeds the named test event from the selected one, if needed.
+func prepareLambdaEvent(functionName, from, name string) (string, error) {
+	path, err := config.LambdaEventPath(functionName, name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err == nil {
+		if from == name {
+			return path, nil
+		}
+	}
+	payload := []byte(emptyLambdaEvent)
+	if from != newLambdaEvent {
+		src, err := config.LambdaEventPath(functionName, from)
+		if err != nil {
+			return "", err
+		}
+		if payload, err = os.ReadFile(src); err != nil {
+			return "", err
+		}
+	}
+	config.EnsurePath(path, config.DefaultDirMod)
+
+	return path, os.WriteFile(path, payload, config.DefaultFileMod)
+}
+
+func (l *Lambda) invoke(functionName, path string, async bool) {
+	if err := editFile(l.App(), path); err != nil {
+		l.App().Flash().Errf("Editor failed: %v", err)
+		return
+	}
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return
+	}
+	if !json.Valid(payload) {
+		l.App().Flash().Errf("Payload %s is not valid JSON", path)
+		return
+	}
+	cfg, ok := l.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		l.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	l.App().Flash().Infof("Invoking %s...", functionName)
+	go func() {
+		res, err := aws.InvokeLambda(cfg, functionName, payload, async)
+		l.App().QueueUpdateDraw(func() {
+			if err != nil {
+				l.App().Flash().Err(err)
+				return
+			}
+			v := NewLiveView(l.App(), "Invoke", model.NewText(functionName, renderInvokeResult(functionName, res)))
+			if err := l.App().inject(v); err != nil {
+				l.App().Flash().Err(err)
+				return
+			}
+			if res.FunctionError != "" {
+				l.App().Flash().Warnf("%s failed with %s", functionName, res.FunctionError)
+				return
+			}
+			l.App().Flash().Infof("%s invoked, status %d", functionName, res.StatusCode)
+		})
+	}()
+}
+
+// renderInvokeResult renders an invocation, escaped so the logs and response
+// are not taken for color or region tags.
+func renderInvokeResult(functionName string, res *aws.LambdaInvokeResp) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Function:         %s\n", functionName)
+	fmt.Fprintf(&b, "Invocation-Type:  %s\n", res.InvocationType)
+	fmt.Fprintf(&b, "Status-Code:      %d\n", res.StatusCode)
+	fmt.Fprintf(&b, "Function-Error:   %s\n", orNone(res.FunctionError))
+	fmt.Fprintf(&b, "Executed-Version: %s\n", orNone(res.ExecutedVersion))
+	if res.LogTail != "" {
+		fmt.Fprintf(&b, "\nLogs (tail):\n%s\n", strings.TrimRight(res.LogTail, "\n"))
+	}
+	if res.Payload != "" {
+		fmt.Fprintf(&b, "\nResponse:\n%s\n", prettyJSON(res.Payload))
+	}
+
+	return tview.Escape(b.String())
+}
+
+// prettyJSON indents a JSON document, returning it untouched if invalid.
+func prettyJSON(s string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	b, err := json.MarshalIndent(v, "", " ")
+	if err != nil {
+		return s
+	}
+	return string(b)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return internal.NONE
+	}
+	return s
+}
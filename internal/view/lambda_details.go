@@ -0,0 +1,174 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+// LambdaVersions represents the published versions of a function.
+type LambdaVersions struct {
+	name string
+	ResourceViewer
+}
+
+func NewLambdaVersions(functionName string) ResourceViewer {
+	var l LambdaVersions
+	l.name = functionName
+	l.ResourceViewer = NewBrowser(internal.LowercaseLambdaVersions)
+	l.AddBindKeysFn(l.bindKeys)
+	return &l
+}
+
+func (l *LambdaVersions) Name() string {
+	return l.name
+}
+
+func (l *LambdaVersions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftM:    ui.NewKeyAction("Sort Last-Modified", l.GetTable().SortColCmd("Last-Modified", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(l, "Version"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.App().PrevCmd, false),
+	})
+}
+
+// LambdaAliases represents the aliases of a function.
+type LambdaAliases struct {
+	name string
+	ResourceViewer
+}
+
+func NewLambdaAliases(functionName string) ResourceViewer {
+	var l LambdaAliases
+	l.name = functionName
+	l.ResourceViewer = NewBrowser(internal.LowercaseLambdaAliases)
+	l.AddBindKeysFn(l.bindKeys)
+	return &l
+}
+
+func (l *LambdaAliases) Name() string {
+	return l.name
+}
+
+func (l *LambdaAliases) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", l.GetTable().SortColCmd("Name", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(l, "Alias"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.App().PrevCmd, false),
+	})
+}
+
+// LambdaEventSources represents the event source mappings of a function.
+type LambdaEventSources struct {
+	name string
+	ResourceViewer
+}
+
+func NewLambdaEventSources(functionName string) ResourceViewer {
+	var l LambdaEventSources
+	l.name = functionName
+	l.ResourceViewer = NewBrowser(internal.LowercaseLambdaEventSources)
+	l.AddBindKeysFn(l.bindKeys)
+	return &l
+}
+
+func (l *LambdaEventSources) Name() string {
+	return l.name
+}
+
+func (l *LambdaEventSources) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Source", l.GetTable().SortColCmd("Source", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(l, "Event source mapping"), true),
+		ui.KeyT:         ui.NewKeyAction("Enable/Disable", l.toggleCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.App().PrevCmd, false),
+	})
+}
+
+func (l *LambdaEventSources) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	uuid := l.GetTable().GetSelectedItem()
+	if uuid == "" {
+		return nil
+	}
+	cfg, ok := l.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		l.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	state := l.GetTable().GetSelectedColumn("State")
+	enable, ok := eventSourceToggle(state)
+	if !ok {
+		l.App().Flash().Warnf("Event source mapping %s is %s, try again once it settles", uuid, state)
+		return nil
+	}
+	action := "Disable"
+	if enable {
+		action = "Enable"
+	}
+	msg := fmt.Sprintf("%s event source mapping %s?", action, uuid)
+	dialog.ShowConfirm(l.App().Content.Pages, "event source", msg, func() {
+		if err := aws.SetLambdaEventSourceEnabled(cfg, uuid, enable); err != nil {
+			l.App().Flash().Err(err)
+			return
+		}
+		l.App().Flash().Infof("%sd event source mapping %s", action, uuid)
+		l.Start()
+	}, func() {})
+	return nil
+}
+
+// eventSourceToggle returns whether toggling a mapping in the given state
+// enables it, mappings transitioning i.e Enabling being toggled according to
+// their target state. Mappings being created, updated or deleted can not be
+// toggled.
+func eventSourceToggle(state string) (enable bool, ok bool) {
+	switch state {
+	case "Enabled", "Enabling":
+		return false, true
+	case "Disabled", "Disabling":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// LambdaLayers represents the layers attached to a function.
+type LambdaLayers struct {
+	name string
+	ResourceViewer
+}
+
+func NewLambdaLayers(functionName string) ResourceViewer {
+	var l LambdaLayers
+	l.name = functionName
+	l.ResourceViewer = NewBrowser(internal.LowercaseLambdaLayers)
+	l.AddBindKeysFn(l.bindKeys)
+	return &l
+}
+
+func (l *LambdaLayers) Name() string {
+	return l.name
+}
+
+func (l *LambdaLayers) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", l.GetTable().SortColCmd("Name", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(l, "Layer"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.App().PrevCmd, false),
+	})
+}
+
+// showLambdaDetails drills into a function sub resource.
+func showLambdaDetails(app *App, functionName, kind string, v ResourceViewer) {
+	ctx := context.WithValue(app.GetContext(), internal.LambdaFunctionName, functionName)
+	app.SetContext(ctx)
+	app.inject(v)
+	v.GetTable().SetTitle(fmt.Sprintf(" lambda://%s/%s ", functionName, kind))
+	app.Flash().Infof("Viewing %s of %s...", kind, functionName)
+}
@@ -3,6 +3,7 @@ package view
 import (
 	"testing"
 
+	"github.com/one2nc/cloudlens/internal/aws"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,5 +11,70 @@ func TestNewLambda(t *testing.T) {
 	lambda := NewLambda("lambda")
 	assert.Nil(t, lambda.Init(makeCtx()))
 	assert.Equal(t, "lambda", lambda.Name())
-	assert.Equal(t, 9, len(lambda.Hints()))
+	assert.Equal(t, 16, len(lambda.Hints()))
+}
+
+func TestNewLambdaDetails(t *testing.T) {
+	uu := map[string]struct {
+		v     ResourceViewer
+		hints int
+	}{
+		"versions":      {v: NewLambdaVersions("fn"), hints: 6},
+		"aliases":       {v: NewLambdaAliases("fn"), hints: 6},
+		"event-sources": {v: NewLambdaEventSources("fn"), hints: 7},
+		"layers":        {v: NewLambdaLayers("fn"), hints: 6},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Nil(t, u.v.Init(makeCtx()))
+			assert.Equal(t, "fn", u.v.Name())
+			assert.Equal(t, u.hints, len(u.v.Hints()))
+		})
+	}
+}
+
+func TestEventSourceToggle(t *testing.T) {
+	uu := map[string]struct {
+		enable, ok bool
+	}{
+		"Enabled":   {enable: false, ok: true},
+		"Enabling":  {enable: false, ok: true},
+		"Disabled":  {enable: true, ok: true},
+		"Disabling": {enable: true, ok: true},
+		"Creating":  {ok: false},
+		"Updating":  {ok: false},
+		"Deleting":  {ok: false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			enable, ok := eventSourceToggle(k)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.enable, enable)
+		})
+	}
+}
+
+func TestRenderInvokeResult(t *testing.T) {
+	res := &aws.LambdaInvokeResp{
+		InvocationType: "RequestResponse",
+		StatusCode:     200,
+		FunctionError:  "Unhandled",
+		LogTail:        "START\nEND\n",
+		Payload:        `{"errorMessage":"boom"}`,
+	}
+
+	s := renderInvokeResult("fn", res)
+
+	assert.Contains(t, s, "Status-Code:      200")
+	assert.Contains(t, s, "Function-Error:   Unhandled")
+	assert.Contains(t, s, "Executed-Version: -")
+	assert.Contains(t, s, "Logs (tail):\nSTART\nEND\n")
+	assert.Contains(t, s, "Response:\n{\n \"errorMessage\": \"boom\"\n}")
+
+	res.LogTail = `keys ["red"]`
+	assert.Contains(t, renderInvokeResult("fn", res), `keys ["red"[]`)
 }
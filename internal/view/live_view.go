@@ -82,9 +82,11 @@ func (v *LiveView) InCmdMode() bool {
 
 // ResourceFailed notifies when their is an issue.
 func (v *LiveView) ResourceFailed(err error) {
-	v.text.SetTextAlign(tview.AlignCenter)
-	x, _, w, _ := v.GetRect()
-	v.text.SetText(cowTalk(err.Error(), x+w))
+	v.app.QueueUpdateDraw(func() {
+		v.text.SetTextAlign(tview.AlignCenter)
+		x, _, w, _ := v.GetRect()
+		v.text.SetText(cowTalk(err.Error(), x+w))
+	})
 }
 
 // ResourceChanged notifies when the filter changes.
@@ -0,0 +1,31 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultPresignExpiry = 15 * time.Minute
+
+// showPresign asks for a presigned url expiry and method, then copies the signed url to the clipboard.
+func showPresign(app *App, object string, def, max time.Duration, sign dialog.PresignFunc) {
+	dialog.ShowPresign(app.Content.Pages, dialog.PresignOpts{
+		Object:        object,
+		DefaultExpiry: def,
+		MaxExpiry:     max,
+		Sign:          sign,
+		Done: func(url string) {
+			// The url is a bearer credential, so only the object is logged.
+			log.Info().Msg(fmt.Sprintf("In view Presigned URL of %v signed", object))
+			if err := clipboard.WriteAll(url); err != nil {
+				app.Flash().Errf("Unable to copy presigned URL to the clipboard: %v", err)
+				return
+			}
+			app.Flash().Info("Presigned URL Copied to Clipboard.")
+		},
+	})
+}
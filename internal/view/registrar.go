@@ -43,6 +43,9 @@ func coreViewers(vv MetaViewers) {
 	vv[internal.LowercaseSQS] = MetaViewer{
 		viewerFn: NewSQS,
 	}
+	vv[internal.LowercaseSQSMessages] = MetaViewer{
+		viewerFn: NewSQSMessages,
+	}
 	vv[internal.LowercaseVPC] = MetaViewer{
 		viewerFn: NewVPC,
 	}
@@ -52,6 +55,18 @@ func coreViewers(vv MetaViewers) {
 	vv[internal.LowercaseLamda] = MetaViewer{
 		viewerFn: NewLambda,
 	}
+	vv[internal.LowercaseLambdaVersions] = MetaViewer{
+		viewerFn: NewLambdaVersions,
+	}
+	vv[internal.LowercaseLambdaAliases] = MetaViewer{
+		viewerFn: NewLambdaAliases,
+	}
+	vv[internal.LowercaseLambdaEventSources] = MetaViewer{
+		viewerFn: NewLambdaEventSources,
+	}
+	vv[internal.LowercaseLambdaLayers] = MetaViewer{
+		viewerFn: NewLambdaLayers,
+	}
 	vv[internal.LowercaseStorage] = MetaViewer{
 		viewerFn: NewStorage,
 	}
@@ -67,6 +82,144 @@ func coreViewers(vv MetaViewers) {
 	vv[internal.LowercaseEcsContainer] = MetaViewer{
 		viewerFn: NewEcsContainer,
 	}
+	vv[internal.LowercaseEcsDeployments] = MetaViewer{
+		viewerFn: NewEcsDeployments,
+	}
+	vv[internal.LowercaseEcsTaskDefinitions] = MetaViewer{
+		viewerFn: NewEcsTaskDefinitionFamilies,
+	}
+	vv[internal.LowercaseEcsTaskDefinitionRevisions] = MetaViewer{
+		viewerFn: NewEcsTaskDefinitions,
+	}
+	vv[internal.LowercaseEks] = MetaViewer{
+		viewerFn: NewEks,
+	}
+	vv[internal.LowercaseEksNodeGroups] = MetaViewer{
+		viewerFn: NewEksNodeGroups,
+	}
+	vv[internal.LowercaseEcr] = MetaViewer{
+		viewerFn: NewEcr,
+	}
+	vv[internal.LowercaseEcrImages] = MetaViewer{
+		viewerFn: NewEcrImages,
+	}
+	vv[internal.LowercaseElb] = MetaViewer{
+		viewerFn: NewElb,
+	}
+	vv[internal.LowercaseElbRules] = MetaViewer{
+		viewerFn: NewElbRules,
+	}
+	vv[internal.LowercaseElbTargets] = MetaViewer{
+		viewerFn: NewElbTargets,
+	}
+	vv[internal.LowercaseAsg] = MetaViewer{
+		viewerFn: NewAsg,
+	}
+	vv[internal.LowercaseAsgInstances] = MetaViewer{
+		viewerFn: NewAsgInstances,
+	}
+	vv[internal.LowercaseAsgActivities] = MetaViewer{
+		viewerFn: NewAsgActivities,
+	}
+	vv[internal.LowercaseR53] = MetaViewer{
+		viewerFn: NewR53,
+	}
+	vv[internal.LowercaseR53Records] = MetaViewer{
+		viewerFn: NewR53Records,
+	}
+	vv[internal.LowercaseCfn] = MetaViewer{
+		viewerFn: NewCfn,
+	}
+	vv[internal.LowercaseCfnResources] = MetaViewer{
+		viewerFn: NewCfnResources,
+	}
+	vv[internal.LowercaseCfnEvents] = MetaViewer{
+		viewerFn: NewCfnEvents,
+	}
+	vv[internal.LowercaseSecrets] = MetaViewer{
+		viewerFn: NewSecrets,
+	}
+	vv[internal.LowercaseSecretVersions] = MetaViewer{
+		viewerFn: NewSecretVersions,
+	}
+	vv[internal.LowercaseSsmParameters] = MetaViewer{
+		viewerFn: NewSsmParameters,
+	}
+	vv[internal.LowercaseSsmParameterVersions] = MetaViewer{
+		viewerFn: NewSsmParameterVersions,
+	}
+	vv[internal.LowercaseKms] = MetaViewer{
+		viewerFn: NewKms,
+	}
+	vv[internal.LowercaseAcm] = MetaViewer{
+		viewerFn: NewAcm,
+	}
+	vv[internal.LowercaseSns] = MetaViewer{
+		viewerFn: NewSns,
+	}
+	vv[internal.LowercaseSnsSubscriptions] = MetaViewer{
+		viewerFn: NewSnsSubscriptions,
+	}
+	vv[internal.LowercaseEvents] = MetaViewer{
+		viewerFn: NewEvents,
+	}
+	vv[internal.LowercaseEventRules] = MetaViewer{
+		viewerFn: NewEventRules,
+	}
+	vv[internal.LowercaseEventTargets] = MetaViewer{
+		viewerFn: NewEventTargets,
+	}
+	vv[internal.LowercaseSchedules] = MetaViewer{
+		viewerFn: NewSchedules,
+	}
+	vv[internal.LowercaseSfn] = MetaViewer{
+		viewerFn: NewSfn,
+	}
+	vv[internal.LowercaseSfnExecutions] = MetaViewer{
+		viewerFn: NewSfnExecutions,
+	}
+	vv[internal.LowercaseSfnHistory] = MetaViewer{
+		viewerFn: NewSfnHistory,
+	}
+	vv[internal.LowercaseKinesis] = MetaViewer{
+		viewerFn: NewKinesis,
+	}
+	vv[internal.LowercaseKinesisShards] = MetaViewer{
+		viewerFn: NewKinesisShards,
+	}
+	vv[internal.LowercaseFirehose] = MetaViewer{
+		viewerFn: NewFirehose,
+	}
+	vv[internal.LowercaseCache] = MetaViewer{
+		viewerFn: NewCache,
+	}
+	vv[internal.LowercaseCacheNodes] = MetaViewer{
+		viewerFn: NewCacheNodes,
+	}
+	vv[internal.LowercaseApigw] = MetaViewer{
+		viewerFn: NewApigw,
+	}
+	vv[internal.LowercaseApiStages] = MetaViewer{
+		viewerFn: NewApiStages,
+	}
+	vv[internal.LowercaseApiRoutes] = MetaViewer{
+		viewerFn: NewApiRoutes,
+	}
+	vv[internal.LowercaseCf] = MetaViewer{
+		viewerFn: NewCf,
+	}
+	vv[internal.LowercaseCfBehaviors] = MetaViewer{
+		viewerFn: NewCfBehaviors,
+	}
+	vv[internal.LowercaseEfs] = MetaViewer{
+		viewerFn: NewEfs,
+	}
+	vv[internal.LowercaseEfsMounts] = MetaViewer{
+		viewerFn: NewEfsMountTargets,
+	}
+	vv[internal.LowercaseEfsAccess] = MetaViewer{
+		viewerFn: NewEfsAccessPoints,
+	}
 	vv[internal.LowerVmInstance] = MetaViewer{
 		viewerFn: NewVM,
 	}
@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+const resolveTimeout = 5 * time.Second
+
+type R53 struct {
+	ResourceViewer
+}
+
+func NewR53(resource string) ResourceViewer {
+	var r R53
+	r.ResourceViewer = NewBrowser(resource)
+	r.AddBindKeysFn(r.bindKeys)
+	return &r
+}
+
+func (r *R53) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", r.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", r.GetTable().SortColCmd("Type", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(r, "Hosted Zone"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", r.enterCmd, false),
+	})
+}
+
+func (r *R53) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	zoneId := r.GetTable().GetSelectedItem()
+	if zoneId == "" {
+		return nil
+	}
+	zoneName := r.GetTable().GetSelectedCell(1)
+	recordsScreen := NewR53Records(zoneName)
+	r.App().SetContext(context.WithValue(r.App().GetContext(), internal.R53HostedZoneId, zoneId))
+	r.App().inject(recordsScreen)
+	recordsScreen.GetTable().SetTitle(fmt.Sprintf(" r53://%s ", strings.TrimSuffix(zoneName, ".")))
+	r.App().Flash().Infof("Viewing %s record sets...", zoneName)
+	return nil
+}
+
+type R53Records struct {
+	name string
+	ResourceViewer
+}
+
+func NewR53Records(zone string) ResourceViewer {
+	var r R53Records
+	r.name = zone
+	r.ResourceViewer = NewBrowser(internal.LowercaseR53Records)
+	r.AddBindKeysFn(r.bindKeys)
+	return &r
+}
+
+func (r *R53Records) Name() string {
+	return r.name
+}
+
+func (r *R53Records) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", r.GetTable().SortColCmd("Type", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(r, "Record"), true),
+		ui.KeyQ:         ui.NewKeyAction("Resolve", r.resolveCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(r, "Record"), false),
+	})
+}
+
+// resolveCmd compares the answer of the local resolver for the selected
+// record with its configured values. Lookups run in the background so a slow
+// resolver does not freeze the ui.
+func (r *R53Records) resolveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := r.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	rtype, routing := r.GetTable().GetSelectedCell(1), r.GetTable().GetSelectedCell(5)
+	cfg, ok := r.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		r.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	zoneId, _ := r.App().GetContext().Value(internal.R53HostedZoneId).(string)
+	r.App().Flash().Infof("Resolving %s %s...", name, rtype)
+	go func() {
+		record, err := aws.GetRecordSet(cfg, zoneId, name, rtype, routing)
+		if err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+		defer cancel()
+		res, err := aws.ResolveRecord(ctx, record)
+		if err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().QueueUpdateDraw(func() {
+			r.showResolution(res)
+		})
+	}()
+	return nil
+}
+
+func (r *R53Records) showResolution(res *aws.RecordResolution) {
+	v := NewLiveView(r.App(), "Resolve", model.NewText(res.Name+" "+res.Type, renderResolution(res)))
+	if err := r.App().inject(v); err != nil {
+		r.App().Flash().Err(err)
+	}
+	if len(res.Missing) == 0 && len(res.Unexpected) == 0 {
+		r.App().Flash().Infof("%s %s resolves to its configured values", res.Name, res.Type)
+		return
+	}
+	r.App().Flash().Warnf("%s %s does not resolve to its configured values", res.Name, res.Type)
+}
+
+func renderResolution(res *aws.RecordResolution) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Record:      %s %s\n", res.Name, res.Type)
+	fmt.Fprintf(&b, "Configured:  %s\n", strings.Join(res.Configured, ", "))
+	fmt.Fprintf(&b, "Resolved:    %s\n", strings.Join(res.Resolved, ", "))
+	if len(res.Missing) == 0 && len(res.Unexpected) == 0 {
+		b.WriteString("Result:      match\n")
+		return b.String()
+	}
+	b.WriteString("Result:      mismatch\n")
+	if len(res.Missing) > 0 {
+		fmt.Fprintf(&b, "Missing:     %s\n", strings.Join(res.Missing, ", "))
+	}
+	if len(res.Unexpected) > 0 {
+		fmt.Fprintf(&b, "Unexpected:  %s\n", strings.Join(res.Unexpected, ", "))
+	}
+	return b.String()
+}
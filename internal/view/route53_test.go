@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewR53(t *testing.T) {
+	r53 := NewR53("r53")
+	assert.Nil(t, r53.Init(makeCtx()))
+	assert.Equal(t, "r53", r53.Name())
+	assert.Equal(t, 8, len(r53.Hints()))
+}
+
+func TestNewR53Records(t *testing.T) {
+	records := NewR53Records("example.com.")
+	assert.Nil(t, records.Init(makeCtx()))
+	assert.Equal(t, "example.com.", records.Name())
+	assert.Equal(t, 8, len(records.Hints()))
+}
+
+func TestRenderResolution(t *testing.T) {
+	res := aws.RecordResolution{Name: "www.example.com.", Type: "A", Configured: []string{"1.2.3.4"}, Resolved: []string{"1.2.3.4"}}
+	assert.Contains(t, renderResolution(&res), "Result:      match")
+
+	res.Resolved, res.Missing, res.Unexpected = []string{"5.6.7.8"}, []string{"1.2.3.4"}, []string{"5.6.7.8"}
+	s := renderResolution(&res)
+	assert.Contains(t, s, "Result:      mismatch")
+	assert.Contains(t, s, "Missing:     1.2.3.4")
+	assert.Contains(t, s, "Unexpected:  5.6.7.8")
+}
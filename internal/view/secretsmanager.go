@@ -0,0 +1,125 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+type Secrets struct {
+	ResourceViewer
+}
+
+func NewSecrets(resource string) ResourceViewer {
+	var s Secrets
+	s.ResourceViewer = NewBrowser(resource)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *Secrets) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftR:    ui.NewKeyAction("Sort Rotation", s.GetTable().SortColCmd("Rotation", true), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Last-Changed", s.GetTable().SortColCmd("Last-Changed", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(s, "Secret"), true),
+		ui.KeyX:         ui.NewKeyAction("Reveal", s.revealCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy Value", s.copyCmd, true),
+		ui.KeyV:         ui.NewKeyAction("Versions", s.versionsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Versions", s.versionsCmd, false),
+	})
+}
+
+func (s *Secrets) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	revealValue(s.App(), name, secretValueFn(s.App(), name, ""))
+	return nil
+}
+
+func (s *Secrets) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	copyValue(s.App(), name, secretValueFn(s.App(), name, ""))
+	return nil
+}
+
+func (s *Secrets) versionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return nil
+	}
+	versionsScreen := NewSecretVersions(name)
+	s.App().SetContext(context.WithValue(s.App().GetContext(), internal.SecretName, name))
+	s.App().inject(versionsScreen)
+	versionsScreen.GetTable().SetTitle(fmt.Sprintf(" secrets://%s/versions ", name))
+	s.App().Flash().Infof("Viewing %s versions...", name)
+	return nil
+}
+
+// secretValueFn fetches the value of a secret version when called.
+func secretValueFn(app *App, name, versionId string) func() (string, error) {
+	return func() (string, error) {
+		cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+		if !ok {
+			return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+		}
+		return aws.GetSecretValue(cfg, name, versionId)
+	}
+}
+
+type SecretVersions struct {
+	name string
+	ResourceViewer
+}
+
+func NewSecretVersions(name string) ResourceViewer {
+	var s SecretVersions
+	s.name = name
+	s.ResourceViewer = NewBrowser(internal.LowercaseSecretVersions)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *SecretVersions) Name() string {
+	return s.name
+}
+
+func (s *SecretVersions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", s.GetTable().SortColCmd("Created", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(s, "Version"), true),
+		ui.KeyX:         ui.NewKeyAction("Reveal", s.revealCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy Value", s.copyCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Reveal", s.revealCmd, false),
+	})
+}
+
+func (s *SecretVersions) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	versionId := s.GetTable().GetSelectedItem()
+	if versionId == "" {
+		return nil
+	}
+	revealValue(s.App(), s.name+"/"+versionId, secretValueFn(s.App(), s.name, versionId))
+	return nil
+}
+
+func (s *SecretVersions) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	versionId := s.GetTable().GetSelectedItem()
+	if versionId == "" {
+		return nil
+	}
+	copyValue(s.App(), s.name+"/"+versionId, secretValueFn(s.App(), s.name, versionId))
+	return nil
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSecrets(t *testing.T) {
+	secrets := NewSecrets("secrets")
+	assert.Nil(t, secrets.Init(makeCtx()))
+	assert.Equal(t, "secrets", secrets.Name())
+	assert.Equal(t, 12, len(secrets.Hints()))
+}
+
+func TestNewSecretVersions(t *testing.T) {
+	versions := NewSecretVersions("prod/db")
+	assert.Nil(t, versions.Init(makeCtx()))
+	assert.Equal(t, "prod/db", versions.Name())
+	assert.Equal(t, 9, len(versions.Hints()))
+}
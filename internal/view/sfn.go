@@ -0,0 +1,317 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/render"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+const emptySfnInput = "{}"
+
+type Sfn struct {
+	ResourceViewer
+}
+
+func NewSfn(resource string) ResourceViewer {
+	var s Sfn
+	s.ResourceViewer = NewBrowser(resource)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *Sfn) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("Type", true), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", s.GetTable().SortColCmd("Created", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", s.describeCmd, true),
+		ui.KeyS:         ui.NewKeyAction("Start Execution", s.startCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Executions", s.enterCmd, false),
+	})
+}
+
+func (s *Sfn) selectedMachine() (string, string) {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return "", ""
+	}
+	return name, s.GetTable().GetSelectedCell(3)
+}
+
+func (s *Sfn) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedMachine()
+	if arn == "" {
+		return nil
+	}
+	executionsScreen := NewSfnExecutions(name)
+	s.App().SetContext(context.WithValue(s.App().GetContext(), internal.SFNStateMachineArn, arn))
+	s.App().inject(executionsScreen)
+	executionsScreen.GetTable().SetTitle(fmt.Sprintf(" sfn://%s ", name))
+	s.App().Flash().Infof("Viewing %s executions...", name)
+	return nil
+}
+
+func (s *Sfn) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedMachine()
+	if arn == "" {
+		return nil
+	}
+	describeResource(s.App(), s.GetTable().GetModel(), s.Resource(), arn)
+	s.App().Flash().Infof("State machine %s", name)
+	return nil
+}
+
+func (s *Sfn) startCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedMachine()
+	if arn == "" {
+		return nil
+	}
+	showStartExecution(s.App(), name, arn, "", nil)
+	return nil
+}
+
+// showStartExecution asks for an execution name and starts an execution of a
+// state machine, the input being edited in $EDITOR. An empty input reuses the
+// last one started.
+func showStartExecution(app *App, name, machineArn, input string, done func()) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	fields := []dialog.FormField{
+		{Label: "Name (optional):"},
+	}
+	msg := fmt.Sprintf("Start %s, the input opens in $EDITOR", name)
+	dialog.ShowForm(app.Content.Pages, "start execution", msg, fields, func(values []string) error {
+		path, err := prepareSfnInput(name, input)
+		if err != nil {
+			return err
+		}
+		// Let the dialog close before handing the terminal over to the editor.
+		app.QueueUpdateDraw(func() {
+			startExecution(app, cfg, machineArn, strings.TrimSpace(values[0]), path, done)
+		})
+		return nil
+	}, func() {})
+}
+
+// prepareSfnInput seeds the input file of a state machine, keeping the last
+// input when none is given.
+func prepareSfnInput(name, input string) (string, error) {
+	path, err := config.SfnInputPath(name)
+	if err != nil {
+		return "", err
+	}
+	if input == "" {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+		input = emptySfnInput
+	}
+	config.EnsurePath(path, config.DefaultDirMod)
+
+	return path, os.WriteFile(path, []byte(prettyJSON(input)+"\n"), config.DefaultFileMod)
+}
+
+func startExecution(app *App, cfg awsV2.Config, machineArn, name, path string, done func()) {
+	if err := editFile(app, path); err != nil {
+		app.Flash().Errf("Editor failed: %v", err)
+		return
+	}
+	input, err := os.ReadFile(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if !json.Valid(input) {
+		app.Flash().Errf("Input %s is not valid JSON", path)
+		return
+	}
+	arn, err := aws.StartExecution(cfg, machineArn, name, string(input))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.Flash().Infof("Execution %s started", arn)
+	if done != nil {
+		done()
+	}
+}
+
+type SfnExecutions struct {
+	name string
+	ResourceViewer
+}
+
+func NewSfnExecutions(name string) ResourceViewer {
+	var s SfnExecutions
+	s.name = name
+	s.ResourceViewer = NewBrowser(internal.LowercaseSfnExecutions)
+	s.GetTable().SetColorerFn(render.Executions{}.ColorerFunc())
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *SfnExecutions) Name() string {
+	return s.name
+}
+
+func (s *SfnExecutions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", s.GetTable().SortColCmd("Status", true), true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Started", s.GetTable().SortColCmd("Started", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", s.describeCmd, true),
+		ui.KeyG:         ui.NewKeyAction("Graph", s.graphCmd, true),
+		ui.KeyS:         ui.NewKeyAction("Start Execution", s.startCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Stop Execution", s.stopCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("History", s.enterCmd, false),
+	})
+}
+
+func (s *SfnExecutions) selectedExecution() (string, string) {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return "", ""
+	}
+	return name, s.GetTable().GetSelectedCell(5)
+}
+
+func (s *SfnExecutions) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedExecution()
+	if arn == "" {
+		return nil
+	}
+	historyScreen := NewSfnHistory(name)
+	s.App().SetContext(context.WithValue(s.App().GetContext(), internal.SFNExecutionArn, arn))
+	s.App().inject(historyScreen)
+	historyScreen.GetTable().SetTitle(fmt.Sprintf(" sfn://%s/%s ", s.name, name))
+	s.App().Flash().Infof("Viewing %s history...", name)
+	return nil
+}
+
+func (s *SfnExecutions) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedExecution()
+	if arn == "" {
+		return nil
+	}
+	describeResource(s.App(), s.GetTable().GetModel(), s.Resource(), arn)
+	s.App().Flash().Infof("Execution %s", name)
+	return nil
+}
+
+func (s *SfnExecutions) graphCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedExecution()
+	if arn == "" {
+		return nil
+	}
+	cfg, ok := s.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		s.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	path, err := aws.GetExecutionPath(cfg, arn)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	text, err := render.StateGraph(path.Definition, path.Visited, path.Failed)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	v := NewLiveView(s.App(), "Graph", model.NewText(name, text))
+	if err := s.App().inject(v); err != nil {
+		s.App().Flash().Err(err)
+	}
+	return nil
+}
+
+// startCmd starts a new execution, prefilling the input of the selected one.
+func (s *SfnExecutions) startCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg, ok := s.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		s.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	machineArn, ok := s.App().GetContext().Value(internal.SFNStateMachineArn).(string)
+	if !ok || machineArn == "" {
+		return nil
+	}
+	var input string
+	if _, arn := s.selectedExecution(); arn != "" {
+		if res, err := aws.GetExecution(cfg, arn); err == nil && res.Input != nil {
+			input = *res.Input
+		}
+	}
+	showStartExecution(s.App(), s.name, machineArn, input, s.Start)
+	return nil
+}
+
+func (s *SfnExecutions) stopCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedExecution()
+	if arn == "" {
+		return nil
+	}
+	if status := s.GetTable().GetSelectedCell(1); status != "RUNNING" {
+		s.App().Flash().Warnf("Execution %s is %s", name, status)
+		return nil
+	}
+	cfg, ok := s.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		s.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	msg := fmt.Sprintf("Stop execution %s?", name)
+	dialog.ShowConfirm(s.App().Content.Pages, "stop execution", msg, func() {
+		if err := aws.StopExecution(cfg, arn); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Execution %s stopped", name)
+		s.Start()
+	}, func() {})
+	return nil
+}
+
+type SfnHistory struct {
+	name string
+	ResourceViewer
+}
+
+func NewSfnHistory(name string) ResourceViewer {
+	var s SfnHistory
+	s.name = name
+	s.ResourceViewer = NewBrowser(internal.LowercaseSfnHistory)
+	s.GetTable().SetColorerFn(render.ExecutionEvents{}.ColorerFunc())
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *SfnHistory) Name() string {
+	return s.name
+}
+
+func (s *SfnHistory) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Timestamp", s.GetTable().SortColCmd("Timestamp", true), true),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort State", s.GetTable().SortColCmd("State", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(s, "Event"), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", describeSelected(s, "Event"), false),
+	})
+}
@@ -0,0 +1,53 @@
+package view
+
+import (
+	"os"
+	"testing"
+
+	"github.com/one2nc/cloudlens/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSfn(t *testing.T) {
+	sfn := NewSfn("sfn")
+	assert.Nil(t, sfn.Init(makeCtx()))
+	assert.Equal(t, "sfn", sfn.Name())
+	assert.Equal(t, 10, len(sfn.Hints()))
+}
+
+func TestNewSfnExecutions(t *testing.T) {
+	executions := NewSfnExecutions("orders")
+	assert.Nil(t, executions.Init(makeCtx()))
+	assert.Equal(t, "orders", executions.Name())
+	assert.Equal(t, 11, len(executions.Hints()))
+}
+
+func TestNewSfnHistory(t *testing.T) {
+	history := NewSfnHistory("run-1")
+	assert.Nil(t, history.Init(makeCtx()))
+	assert.Equal(t, "run-1", history.Name())
+	assert.Equal(t, 8, len(history.Hints()))
+}
+
+func TestPrepareSfnInput(t *testing.T) {
+	t.Setenv(config.CloudlensConfig, t.TempDir())
+
+	path, err := prepareSfnInput("orders", "")
+	assert.Nil(t, err)
+	b, _ := os.ReadFile(path)
+	assert.Equal(t, "{}\n", string(b))
+
+	path, err = prepareSfnInput("orders", `{"id":1}`)
+	assert.Nil(t, err)
+	b, _ = os.ReadFile(path)
+	assert.Equal(t, "{\n \"id\": 1\n}\n", string(b))
+
+	// The last input is kept when none is given.
+	_, err = prepareSfnInput("orders", "")
+	assert.Nil(t, err)
+	b, _ = os.ReadFile(path)
+	assert.Equal(t, "{\n \"id\": 1\n}\n", string(b))
+
+	_, err = prepareSfnInput("../orders", "")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,53 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+)
+
+const sshCmd = "ssh"
+
+const (
+	shellSSM = "SSM"
+	shellSSH = "SSH"
+)
+
+// runShell suspends the app for the duration of an interactive session.
+func runShell(app *App, target string, cmd *aws.ShellCommand) {
+	if err := runInteractive(app, cmd.Name, cmd.Args...); err != nil {
+		app.Flash().Errf("Session to %s failed: %v", target, err)
+		return
+	}
+	app.Flash().Infof("Session to %s ended", target)
+}
+
+// sshCommand returns the ssh invocation for a host.
+func sshCommand(user, key, host string) (*aws.ShellCommand, error) {
+	path, err := exec.LookPath(sshCmd)
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("%s not found in PATH, install an ssh client or use %s instead", sshCmd, shellSSM)
+		}
+		return nil, err
+	}
+	var args []string
+	if key = strings.TrimSpace(key); key != "" {
+		args = append(args, "-i", key)
+	}
+	if user = strings.TrimSpace(user); user != "" {
+		host = user + "@" + host
+	}
+
+	return &aws.ShellCommand{Name: path, Args: append(args, host)}, nil
+}
+
+// activeProfile returns the active aws profile, if any.
+func activeProfile(app *App) string {
+	profile, _ := app.GetContext().Value(internal.KeyActiveProfile).(string)
+	return profile
+}
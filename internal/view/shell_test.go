@@ -0,0 +1,22 @@
+package view
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHCommand(t *testing.T) {
+	if _, err := exec.LookPath(sshCmd); err != nil {
+		t.Skip("ssh is not installed")
+	}
+
+	cmd, err := sshCommand("ec2-user", "/keys/id.pem", "ec2-1-2-3-4.compute.amazonaws.com")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-i", "/keys/id.pem", "ec2-user@ec2-1-2-3-4.compute.amazonaws.com"}, cmd.Args)
+
+	cmd, err = sshCommand("", " ", "10.0.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, cmd.Args)
+}
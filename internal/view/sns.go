@@ -0,0 +1,154 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+// Sns lists SNS topics, only the ones feeding endpoint when it isn't empty.
+type Sns struct {
+	endpoint string
+	ResourceViewer
+}
+
+func NewSns(resource string) ResourceViewer {
+	return newSns("")
+}
+
+func newSns(endpoint string) *Sns {
+	var s Sns
+	s.endpoint = endpoint
+	s.ResourceViewer = NewBrowser(internal.LowercaseSns)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+// Init lists the topics feeding the viewer endpoint.
+func (s *Sns) Init(ctx context.Context) error {
+	return s.ResourceViewer.Init(context.WithValue(ctx, internal.SNSEndpoint, s.endpoint))
+}
+
+func (s *Sns) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("Type", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", s.describeCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Publish", s.publishCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Subscriptions", s.enterCmd, false),
+	})
+}
+
+func (s *Sns) selectedTopic() (string, string) {
+	name := s.GetTable().GetSelectedItem()
+	if name == "" {
+		return "", ""
+	}
+	return name, s.GetTable().GetSelectedCell(5)
+}
+
+func (s *Sns) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedTopic()
+	if arn == "" {
+		return nil
+	}
+	subsScreen := NewSnsSubscriptions(name)
+	s.App().SetContext(context.WithValue(s.App().GetContext(), internal.SNSTopicArn, arn))
+	s.App().inject(subsScreen)
+	subsScreen.GetTable().SetTitle(fmt.Sprintf(" sns://%s ", name))
+	s.App().Flash().Infof("Viewing %s subscriptions...", name)
+	return nil
+}
+
+func (s *Sns) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedTopic()
+	if arn == "" {
+		return nil
+	}
+	describeResource(s.App(), s.GetTable().GetModel(), s.Resource(), arn)
+	s.App().Flash().Infof("Topic %s", name)
+	return nil
+}
+
+func (s *Sns) publishCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, arn := s.selectedTopic()
+	if arn == "" {
+		return nil
+	}
+	showPublish(s.App(), name, arn)
+	return nil
+}
+
+// showPublish asks for a test message and publishes it to a topic.
+func showPublish(app *App, name, topicArn string) {
+	cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		app.Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return
+	}
+	fields := []dialog.FormField{
+		{Label: "Subject:"},
+		{Label: "Message:", Value: "test message from cloudlens"},
+		{Label: "Attributes (k=v,...):"},
+	}
+	fifo := aws.IsFifoTopic(topicArn)
+	if fifo {
+		fields = append(fields, dialog.FormField{Label: "Group Id:"}, dialog.FormField{Label: "Dedup Id:"})
+	}
+	dialog.ShowForm(app.Content.Pages, "publish", name, fields, func(values []string) error {
+		var groupId, dedupId string
+		if fifo {
+			groupId, dedupId = values[3], values[4]
+		}
+		id, err := aws.Publish(cfg, topicArn, values[0], values[1], values[2], groupId, dedupId)
+		if err != nil {
+			return err
+		}
+		app.Flash().Infof("Message %s published to %s", id, name)
+		return nil
+	}, func() {})
+}
+
+type SnsSubscriptions struct {
+	name string
+	ResourceViewer
+}
+
+func NewSnsSubscriptions(name string) ResourceViewer {
+	var s SnsSubscriptions
+	s.name = name
+	s.ResourceViewer = NewBrowser(internal.LowercaseSnsSubscriptions)
+	s.AddBindKeysFn(s.bindKeys)
+	return &s
+}
+
+func (s *SnsSubscriptions) Name() string {
+	return s.name
+}
+
+func (s *SnsSubscriptions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP:    ui.NewKeyAction("Sort Protocol", s.GetTable().SortColCmd("Protocol", true), true),
+		ui.KeyShiftE:    ui.NewKeyAction("Sort Endpoint", s.GetTable().SortColCmd("Endpoint", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(s, "Subscription"), true),
+		ui.KeyP:         ui.NewKeyAction("Publish", s.publishCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Describe", describeSelected(s, "Subscription"), false),
+	})
+}
+
+func (s *SnsSubscriptions) publishCmd(evt *tcell.EventKey) *tcell.EventKey {
+	arn, ok := s.App().GetContext().Value(internal.SNSTopicArn).(string)
+	if !ok || arn == "" {
+		return nil
+	}
+	showPublish(s.App(), s.name, arn)
+	return nil
+}
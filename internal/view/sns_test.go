@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSns(t *testing.T) {
+	sns := NewSns("sns")
+	assert.Nil(t, sns.Init(makeCtx()))
+	assert.Equal(t, "sns", sns.Name())
+	assert.Equal(t, 9, len(sns.Hints()))
+}
+
+func TestNewSnsSubscriptions(t *testing.T) {
+	subs := NewSnsSubscriptions("orders")
+	assert.Nil(t, subs.Init(makeCtx()))
+	assert.Equal(t, "orders", subs.Name())
+	assert.Equal(t, 9, len(subs.Hints()))
+}
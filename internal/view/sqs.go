@@ -1,7 +1,13 @@
 package view
 
 import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
 	"github.com/one2nc/cloudlens/internal/ui"
 )
 
@@ -24,11 +30,53 @@ func (sqs *SQS) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", sqs.GetTable().SortColCmd("Type", true), true),
 		ui.KeyShiftC:    ui.NewKeyAction("Sort Created", sqs.GetTable().SortColCmd("Created", true), true),
 		ui.KeyShiftM:    ui.NewKeyAction("Sort Messages-Available", sqs.GetTable().SortColCmd("Messages-Available", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", sqs.describeQueue, true),
+		ui.KeyT:         ui.NewKeyAction("Topics", sqs.topicsCmd, true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", sqs.App().PrevCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("View", sqs.enterCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View Messages", sqs.enterCmd, false),
 	})
 }
+
 func (sqs *SQS) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	queueUrl := sqs.GetTable().GetSelectedItem()
+	if queueUrl == "" {
+		return nil
+	}
+	queueName := sqs.GetTable().GetSecondColumn()
+	msgScreen := NewSQSMessages(queueName)
+	ctx := context.WithValue(sqs.App().GetContext(), internal.SQSQueueUrl, queueUrl)
+	sqs.App().SetContext(ctx)
+	sqs.App().inject(msgScreen)
+	msgScreen.GetTable().SetTitle(fmt.Sprintf(" sqs://%s ", queueName))
+	msgScreen.App().Flash().Info("Queue URL:" + queueUrl)
+	return nil
+}
+
+// topicsCmd lists the SNS topics delivering to the selected queue.
+func (sqs *SQS) topicsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	queueUrl := sqs.GetTable().GetSelectedItem()
+	if queueUrl == "" {
+		return nil
+	}
+	queueName := sqs.GetTable().GetSecondColumn()
+	cfg, ok := sqs.App().GetContext().Value(internal.KeySession).(awsV2.Config)
+	if !ok {
+		sqs.App().Flash().Errf("conversion err: Expected awsV2.Config but got %v", cfg)
+		return nil
+	}
+	queueArn, err := aws.QueueArn(cfg, queueUrl)
+	if err != nil {
+		sqs.App().Flash().Err(err)
+		return nil
+	}
+	topicsScreen := newSns(queueArn)
+	sqs.App().inject(topicsScreen)
+	topicsScreen.GetTable().SetTitle(fmt.Sprintf(" sns://%s ", queueName))
+	sqs.App().Flash().Infof("Viewing topics feeding %s...", queueName)
+	return nil
+}
+
+func (sqs *SQS) describeQueue(evt *tcell.EventKey) *tcell.EventKey {
 	queueUrl := sqs.GetTable().GetSelectedItem()
 	if queueUrl != "" {
 		f := describeResource
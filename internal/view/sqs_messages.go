@@ -0,0 +1,199 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/dao"
+	"github.com/one2nc/cloudlens/internal/model"
+	"github.com/one2nc/cloudlens/internal/ui"
+	"github.com/one2nc/cloudlens/internal/ui/dialog"
+)
+
+type SQSMessages struct {
+	name string
+	peek bool
+	ResourceViewer
+}
+
+func NewSQSMessages(queueName string) ResourceViewer {
+	var m SQSMessages
+	m.name = queueName
+	m.ResourceViewer = NewBrowser(internal.LowercaseSQSMessages)
+	m.AddBindKeysFn(m.bindKeys)
+	return &m
+}
+
+// Init peeks at the messages once, later listings showing the peeked ones
+// until peeked again.
+func (m *SQSMessages) Init(ctx context.Context) error {
+	if err := m.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	m.peek = true
+	m.SetContextFn(func(c context.Context) context.Context {
+		return context.WithValue(ctx, internal.SQSPeekMessages, m.peek)
+	})
+	return nil
+}
+
+// Start lists the messages, receiving them only when a peek was asked for.
+func (m *SQSMessages) Start() {
+	m.ResourceViewer.Start()
+	m.peek = false
+}
+
+func (m *SQSMessages) Name() string {
+	return m.name
+}
+
+func (m *SQSMessages) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Sent", m.GetTable().SortColCmd("Sent", true), true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Receive-Count", m.GetTable().SortColCmd("Receive-Count", true), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", m.describeMessage, true),
+		ui.KeyP:         ui.NewKeyAction("Peek", m.peekCmd, true),
+		ui.KeyN:         ui.NewKeyAction("Send Message", m.sendCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Delete", m.deleteCmd, true),
+		tcell.KeyCtrlX:  ui.NewKeyAction("Purge Queue", m.purgeCmd, true),
+		ui.KeyShiftR:    ui.NewKeyAction("Redrive DLQ", m.redriveCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", m.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", m.describeMessage, false),
+	})
+}
+
+func (m *SQSMessages) queue() (awsV2.Config, string) {
+	ctx := m.App().GetContext()
+	cfg, _ := ctx.Value(internal.KeySession).(awsV2.Config)
+	queueUrl, _ := ctx.Value(internal.SQSQueueUrl).(string)
+	return cfg, queueUrl
+}
+
+// messages returns the messages dao holding the peeked messages.
+func (m *SQSMessages) messages() *dao.SQSMessages {
+	d, _ := model.Registry[internal.LowercaseSQSMessages].DAO.(*dao.SQSMessages)
+	return d
+}
+
+func (m *SQSMessages) describeMessage(evt *tcell.EventKey) *tcell.EventKey {
+	messageId := m.GetTable().GetSelectedItem()
+	if messageId == "" {
+		return nil
+	}
+	f := describeResource
+	if m.GetTable().enterFn != nil {
+		f = m.GetTable().enterFn
+	}
+	f(m.App(), m.GetTable().GetModel(), m.Resource(), messageId)
+	m.App().Flash().Infof("Message %s", messageId)
+	return nil
+}
+
+func (m *SQSMessages) peekCmd(evt *tcell.EventKey) *tcell.EventKey {
+	m.peek = true
+	m.Start()
+	m.App().Flash().Infof("Peeked at %d message(s) of %s", m.GetTable().GetModel().Count(), m.name)
+	return nil
+}
+
+func (m *SQSMessages) sendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg, queueUrl := m.queue()
+	fields := []dialog.FormField{{Label: "Body:"}}
+	fifo := aws.IsFifoQueue(queueUrl)
+	if fifo {
+		fields = append(fields, dialog.FormField{Label: "Group Id:"}, dialog.FormField{Label: "Dedup Id:"})
+	}
+	dialog.ShowForm(m.App().Content.Pages, "send message", m.name, fields, func(values []string) error {
+		var groupId, dedupId string
+		if fifo {
+			groupId, dedupId = values[1], values[2]
+		}
+		id, err := aws.SendMessage(cfg, queueUrl, values[0], groupId, dedupId)
+		if err != nil {
+			return err
+		}
+		m.App().Flash().Infof("Message %s sent, peek to see it", id)
+		return nil
+	}, func() {})
+	return nil
+}
+
+func (m *SQSMessages) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := m.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return nil
+	}
+	d := m.messages()
+	if d == nil {
+		return nil
+	}
+	handles := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if msg, ok := d.Message(id); ok {
+			handles = append(handles, msg.ReceiptHandle)
+		}
+	}
+	if len(handles) == 0 {
+		m.App().Flash().Warn("No peeked message to delete, peek and try again")
+		return nil
+	}
+	cfg, queueUrl := m.queue()
+	// Peeked messages are made visible again right away, so a message received
+	// by another consumer since may not be deleted by its old receipt handle.
+	msg := fmt.Sprintf("Delete %d message(s) from %s? Messages received by another consumer since the peek may not be deleted.", len(handles), m.name)
+	dialog.ShowConfirm(m.App().Content.Pages, "delete", msg, func() {
+		if err := aws.DeleteMessages(cfg, queueUrl, handles); err != nil {
+			m.App().Flash().Err(err)
+			return
+		}
+		d.Forget(ids...)
+		m.GetTable().ClearMarks()
+		m.App().Flash().Infof("%d message(s) deleted", len(handles))
+		m.Start()
+	}, func() {})
+	return nil
+}
+
+func (m *SQSMessages) purgeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg, queueUrl := m.queue()
+	msg := fmt.Sprintf("Purge all messages of %s? This can not be undone.", m.name)
+	dialog.ShowConfirm(m.App().Content.Pages, "purge", msg, func() {
+		if err := aws.PurgeQueue(cfg, queueUrl); err != nil {
+			m.App().Flash().Err(err)
+			return
+		}
+		if d := m.messages(); d != nil {
+			d.Forget()
+		}
+		m.App().Flash().Infof("Queue %s purged", m.name)
+		m.Start()
+	}, func() {})
+	return nil
+}
+
+func (m *SQSMessages) redriveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg, queueUrl := m.queue()
+	msg := fmt.Sprintf("Move all messages of dead letter queue %s back to its source queue?", m.name)
+	dialog.ShowConfirm(m.App().Content.Pages, "redrive", msg, func() {
+		m.App().Flash().Infof("Redriving messages of %s...", m.name)
+		go func() {
+			n, err := aws.RedriveMessages(cfg, queueUrl)
+			if err != nil {
+				m.App().Flash().Errf("Redrive stopped after %d message(s): %v", n, err)
+			} else {
+				m.App().Flash().Infof("%d message(s) redriven", n)
+			}
+			m.App().QueueUpdateDraw(func() {
+				if d := m.messages(); d != nil {
+					d.Forget()
+				}
+				m.Start()
+			})
+		}()
+	}, func() {})
+	return nil
+}
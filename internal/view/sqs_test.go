@@ -10,5 +10,12 @@ func TestNewSQS(t *testing.T) {
 	sqs := NewSQS("sqs")
 	assert.Nil(t, sqs.Init(makeCtx()))
 	assert.Equal(t, "sqs", sqs.Name())
-	assert.Equal(t, 9, len(sqs.Hints()))
+	assert.Equal(t, 11, len(sqs.Hints()))
+}
+
+func TestNewSQSMessages(t *testing.T) {
+	m := NewSQSMessages("orders")
+	assert.Nil(t, m.Init(makeCtx()))
+	assert.Equal(t, "orders", m.Name())
+	assert.Equal(t, 13, len(m.Hints()))
 }
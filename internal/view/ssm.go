@@ -0,0 +1,171 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	awsV2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gdamore/tcell/v2"
+	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/aws"
+	"github.com/one2nc/cloudlens/internal/ui"
+)
+
+// SsmParameters browses SSM parameters one path level at a time.
+type SsmParameters struct {
+	path string
+	ResourceViewer
+}
+
+func NewSsmParameters(resource string) ResourceViewer {
+	return newSsmParameters("/")
+}
+
+func newSsmParameters(path string) *SsmParameters {
+	var p SsmParameters
+	p.path = path
+	p.ResourceViewer = NewBrowser(internal.LowercaseSsmParameters)
+	p.AddBindKeysFn(p.bindKeys)
+	return &p
+}
+
+// Init lists the parameters of the viewer path.
+func (p *SsmParameters) Init(ctx context.Context) error {
+	return p.ResourceViewer.Init(context.WithValue(ctx, internal.SSMParameterPath, p.path))
+}
+
+func (p *SsmParameters) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", p.GetTable().SortColCmd("Name", true), true),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Type", p.GetTable().SortColCmd("Type", true), true),
+		ui.KeyShiftL:    ui.NewKeyAction("Sort Last-Modified", p.GetTable().SortColCmd("Last-Modified", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", p.describeCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Reveal", p.revealCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy Value", p.copyCmd, true),
+		ui.KeyV:         ui.NewKeyAction("Versions", p.versionsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View", p.enterCmd, false),
+	})
+}
+
+// selectedParameter returns the full name of the selected parameter, folders
+// excluded.
+func (p *SsmParameters) selectedParameter() string {
+	if p.GetTable().GetSelectedItem() == "" || p.GetTable().GetSelectedCell(2) == internal.FOLDER_TYPE {
+		return ""
+	}
+	return p.GetTable().GetSelectedCell(1)
+}
+
+func (p *SsmParameters) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if p.GetTable().GetSelectedCell(2) != internal.FOLDER_TYPE {
+		return p.versionsCmd(evt)
+	}
+	path := p.GetTable().GetSelectedCell(1)
+	if path == "" {
+		return nil
+	}
+	folderScreen := newSsmParameters(path)
+	p.App().inject(folderScreen)
+	folderScreen.GetTable().SetTitle(fmt.Sprintf(" ssm://%s ", path))
+	p.App().Flash().Infof("Viewing %s parameters...", path)
+	return nil
+}
+
+func (p *SsmParameters) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.selectedParameter()
+	if name == "" {
+		return nil
+	}
+	describeResource(p.App(), p.GetTable().GetModel(), p.Resource(), name)
+	p.App().Flash().Infof("Parameter %s", name)
+	return nil
+}
+
+func (p *SsmParameters) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.selectedParameter()
+	if name == "" {
+		return nil
+	}
+	revealValue(p.App(), name, parameterValueFn(p.App(), name, ""))
+	return nil
+}
+
+func (p *SsmParameters) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.selectedParameter()
+	if name == "" {
+		return nil
+	}
+	copyValue(p.App(), name, parameterValueFn(p.App(), name, ""))
+	return nil
+}
+
+func (p *SsmParameters) versionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.selectedParameter()
+	if name == "" {
+		return nil
+	}
+	versionsScreen := NewSsmParameterVersions(name)
+	p.App().SetContext(context.WithValue(p.App().GetContext(), internal.SSMParameterName, name))
+	p.App().inject(versionsScreen)
+	versionsScreen.GetTable().SetTitle(fmt.Sprintf(" ssm://%s/versions ", name))
+	p.App().Flash().Infof("Viewing %s versions...", name)
+	return nil
+}
+
+// parameterValueFn fetches the value of a parameter version when called.
+func parameterValueFn(app *App, name, version string) func() (string, error) {
+	return func() (string, error) {
+		cfg, ok := app.GetContext().Value(internal.KeySession).(awsV2.Config)
+		if !ok {
+			return "", fmt.Errorf("conversion err: Expected awsV2.Config but got %v", cfg)
+		}
+		return aws.GetParameterValue(cfg, name, version)
+	}
+}
+
+type SsmParameterVersions struct {
+	name string
+	ResourceViewer
+}
+
+func NewSsmParameterVersions(name string) ResourceViewer {
+	var p SsmParameterVersions
+	p.name = name
+	p.ResourceViewer = NewBrowser(internal.LowercaseSsmParameterVersions)
+	p.AddBindKeysFn(p.bindKeys)
+	return &p
+}
+
+func (p *SsmParameterVersions) Name() string {
+	return p.name
+}
+
+func (p *SsmParameterVersions) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftL:    ui.NewKeyAction("Sort Last-Modified", p.GetTable().SortColCmd("Last-Modified", false), true),
+		ui.KeyD:         ui.NewKeyAction("Describe", describeSelected(p, "Version"), true),
+		ui.KeyX:         ui.NewKeyAction("Reveal", p.revealCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy Value", p.copyCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.App().PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Reveal", p.revealCmd, false),
+	})
+}
+
+func (p *SsmParameterVersions) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	version := p.GetTable().GetSelectedItem()
+	if version == "" {
+		return nil
+	}
+	revealValue(p.App(), p.name+":"+version, parameterValueFn(p.App(), p.name, version))
+	return nil
+}
+
+func (p *SsmParameterVersions) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	version := p.GetTable().GetSelectedItem()
+	if version == "" {
+		return nil
+	}
+	copyValue(p.App(), p.name+":"+version, parameterValueFn(p.App(), p.name, version))
+	return nil
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSsmParameters(t *testing.T) {
+	params := NewSsmParameters("ssm:p")
+	assert.Nil(t, params.Init(makeCtx()))
+	assert.Equal(t, "ssm:p", params.Name())
+	assert.Equal(t, 12, len(params.Hints()))
+}
+
+func TestNewSsmParameterVersions(t *testing.T) {
+	versions := NewSsmParameterVersions("/app/db/password")
+	assert.Nil(t, versions.Init(makeCtx()))
+	assert.Equal(t, "/app/db/password", versions.Name())
+	assert.Equal(t, 9, len(versions.Hints()))
+}
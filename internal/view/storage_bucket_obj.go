@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
-	"github.com/atotto/clipboard"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
 	"github.com/one2nc/cloudlens/internal/gcp"
@@ -19,6 +19,7 @@ type StorageFileViewer struct {
 	path       string
 	bucketName string
 	ResourceViewer
+	objectDownloads
 }
 
 func NewStorageFileViewer(path string, bucketName string, folderName string) *StorageFileViewer {
@@ -40,6 +41,12 @@ func (obj *StorageFileViewer) Name() string {
 	return obj.bucketName
 }
 
+// Stop cancels the downloads in progress when leaving the view.
+func (obj *StorageFileViewer) Stop() {
+	obj.cancelDownloads()
+	obj.ResourceViewer.Stop()
+}
+
 func (obj *StorageFileViewer) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", obj.GetTable().SortColCmd("Name", true), true),
@@ -48,7 +55,7 @@ func (obj *StorageFileViewer) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftC:    ui.NewKeyAction("Sort Storage-Class", obj.GetTable().SortColCmd("Storage-Class", true), true),
 		tcell.KeyEscape: ui.NewKeyAction("Back", obj.App().PrevCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("View", obj.enterCmd, false),
-		tcell.KeyCtrlD:  ui.NewKeyAction("Download Object", obj.downloadCmd, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Download", obj.downloadCmd, true),
 		tcell.KeyCtrlP:  ui.NewKeyAction("Pre-Signed URL", obj.preSignedUrlCmd, true),
 	})
 }
@@ -76,14 +83,13 @@ func (obj *StorageFileViewer) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (obj *StorageFileViewer) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
-	objName := obj.GetTable().GetSelectedItem()
-	fileType := obj.GetTable().GetSecondColumn()
-	ctx := obj.App().GetContext()
-
-	if fileType == internal.FILE_TYPE {
-		res := gcp.DownloadObject(ctx, obj.bucketName, obj.path, objName)
-		obj.App().Flash().Info(res)
+	src, err := gcp.NewStorageSource(obj.App().GetContext(), obj.bucketName)
+	if err != nil {
+		obj.App().Flash().Err(err)
+		return nil
 	}
+	items := selectedObjects(obj.GetTable())
+	downloadObjects(obj.downloadContext(), obj.App(), obj.GetTable(), src, obj.path, items, func() { src.Close() })
 
 	return nil
 }
@@ -94,12 +100,9 @@ func (obj *StorageFileViewer) preSignedUrlCmd(evt *tcell.EventKey) *tcell.EventK
 	ctx := obj.App().GetContext()
 
 	if fileType == internal.FILE_TYPE {
-		url := gcp.GetPreSignedUrl(ctx, obj.bucketName, obj.path, objName)
-		if url != "" {
-			log.Info().Msg(fmt.Sprintf("In view Presigned URL: %v", url))
-			clipboard.WriteAll(url)
-			obj.App().Flash().Info("Presigned URL Copied to Clipboard.")
-		}
+		showPresign(obj.App(), objName, defaultPresignExpiry, gcp.StorageMaxSignedURLExpiry, func(method string, expiry time.Duration) (string, error) {
+			return gcp.GetPreSignedUrl(ctx, obj.bucketName, obj.path, objName, method, expiry)
+		})
 	}
 
 	return nil
@@ -14,6 +14,7 @@ import (
 	"github.com/atotto/clipboard"
 	"github.com/gdamore/tcell/v2"
 	"github.com/one2nc/cloudlens/internal"
+	"github.com/one2nc/cloudlens/internal/model"
 	"github.com/one2nc/cloudlens/internal/ui"
 	"github.com/rs/zerolog/log"
 )
@@ -29,12 +30,14 @@ type Table struct {
 	app        *App
 	enterFn    EnterFunc
 	bindKeysFn []BindKeysFunc
+	filterBuff *model.FishBuff
 }
 
 // NewTable returns a new viewer.
 func NewTable(res string) *Table {
 	t := Table{
-		Table: ui.NewTable(res),
+		Table:      ui.NewTable(res),
+		filterBuff: model.NewFishBuff('/', model.FilterBuffer),
 	}
 	return &t
 }
@@ -47,6 +50,7 @@ func (t *Table) Init(ctx context.Context) (err error) {
 
 	t.Table.Init(ctx)
 	t.SetInputCapture(t.keyboard)
+	t.filterBuff.AddListener(t)
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(DefaultRefreshRate)
 
@@ -86,12 +90,59 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
-		tcell.KeyCtrlW: ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
-		ui.KeyHelp:     ui.NewKeyAction("Help", t.App().helpCmd, true),
-		ui.KeyZ:        ui.NewKeyAction("CSV", t.importAsCSV, true),
+		tcell.KeyCtrlW:     ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
+		ui.KeyHelp:         ui.NewKeyAction("Help", t.App().helpCmd, true),
+		ui.KeyZ:            ui.NewKeyAction("CSV", t.importAsCSV, true),
+		ui.KeySpace:        ui.NewSharedKeyAction("Mark", t.markCmd, false),
+		tcell.KeyCtrlSpace: ui.NewSharedKeyAction("Clear Marks", t.clearMarksCmd, false),
+		ui.KeySlash:        ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
 	})
 }
 
+func (t *Table) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.app.InCmdMode() {
+		return evt
+	}
+	t.app.ResetPrompt(t.filterBuff)
+	return nil
+}
+
+// Filter filters the rows as if the query was typed in filter mode.
+func (t *Table) Filter(q string) {
+	t.filterBuff.SetText(q, "")
+}
+
+// ClearFilter clears out the row filter.
+func (t *Table) ClearFilter() {
+	t.filterBuff.ClearText(true)
+}
+
+// BufferChanged indicates the buffer was changed.
+func (t *Table) BufferChanged(_, _ string) {}
+
+// BufferCompleted indicates input was accepted.
+func (t *Table) BufferCompleted(text, _ string) {
+	t.app.QueueUpdateDraw(func() {
+		t.SetFilter(text)
+	})
+}
+
+// BufferActive indicates the buff activity changed.
+func (t *Table) BufferActive(state bool, k model.BufferKind) {
+	t.app.BufferActive(state, k)
+}
+
+func (t *Table) markCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleMark()
+	t.Refresh()
+	return nil
+}
+
+func (t *Table) clearMarksCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ClearMarks()
+	return nil
+}
+
 // Name returns the table name.
 func (t *Table) Name() string { return t.Table.Resource() }
 